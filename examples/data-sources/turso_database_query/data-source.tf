@@ -0,0 +1,14 @@
+# Reads tenant placement from a control-plane database.
+data "turso_database_query" "tenants" {
+  id   = "control-plane"
+  sql  = "SELECT name, region FROM tenants WHERE plan = ?"
+  args = ["enterprise"]
+}
+
+resource "turso_group" "tenant" {
+  for_each = { for tenant in data.turso_database_query.tenants.rows : tenant.name => tenant }
+
+  name      = each.key
+  primary   = each.value.region
+  locations = [each.value.region]
+}
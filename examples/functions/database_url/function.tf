@@ -0,0 +1,3 @@
+output "database_url" {
+  value = provider::turso::database_url("a-database", "an-organization", "libsql")
+}
@@ -0,0 +1,13 @@
+data "turso_database_token" "example" {
+  id            = "a-database"
+  expiration    = "2w"
+  authorization = "read-only"
+}
+
+locals {
+  claims = provider::turso::jwt_claims(data.turso_database_token.example.jwt)
+}
+
+output "token_expires_at" {
+  value = nonsensitive(local.claims.expires_at)
+}
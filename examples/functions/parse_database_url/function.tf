@@ -0,0 +1,12 @@
+variable "connection_string" {
+  type      = string
+  sensitive = true
+}
+
+locals {
+  database = provider::turso::parse_database_url(var.connection_string)
+}
+
+output "database_hostname" {
+  value = local.database.hostname
+}
@@ -0,0 +1,9 @@
+variable "size_limit" {
+  type    = string
+  default = "256mb"
+
+  validation {
+    condition     = provider::turso::parse_size(var.size_limit) <= provider::turso::parse_size("1gb")
+    error_message = "The size limit must be at most 1gb."
+  }
+}
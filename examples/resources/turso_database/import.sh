@@ -1 +1,15 @@
-terraform import turso_database.example_database database_name
\ No newline at end of file
+terraform import turso_database.example_database database_name
+
+# The database name can be qualified by the organization it belongs to.
+terraform import turso_database.example_database organization_name/database_name
+
+# With Terraform 1.12 and later, the database can also be imported by its
+# identity in an import block. The organization is optional.
+#
+#   import {
+#     to = turso_database.example_database
+#     identity = {
+#       organization = "organization_name"
+#       name         = "database_name"
+#     }
+#   }
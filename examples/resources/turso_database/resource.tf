@@ -2,3 +2,44 @@ resource "turso_database" "example" {
   group = "a-group"
   name  = "a-database"
 }
+
+resource "turso_database" "seeded" {
+  group = "a-group"
+  name  = "a-seeded-database"
+
+  seed = {
+    dump_file = "${path.module}/seed.sql"
+  }
+}
+
+# An ephemeral branch of a-database for a preview environment, created only
+# while preview_branch is set. The branch is taken from the state of the
+# source database at preview_timestamp, an RFC 3339 timestamp within its
+# recovery window, or from its latest state when preview_timestamp is unset.
+# Deletion protection is turned off so that the branch can be destroyed when
+# preview_branch is unset.
+variable "preview_branch" {
+  type    = string
+  default = null
+}
+
+variable "preview_timestamp" {
+  type    = string
+  default = null
+}
+
+resource "turso_database" "preview" {
+  count = var.preview_branch == null ? 0 : 1
+
+  group               = turso_database.example.group
+  name                = "a-database-${var.preview_branch}"
+  deletion_protection = false
+
+  # Keep a SQL dump of the branch when it is destroyed.
+  final_snapshot = "${path.module}/snapshots/a-database-${var.preview_branch}.sql"
+
+  seed = {
+    database  = turso_database.example.name
+    timestamp = var.preview_timestamp
+  }
+}
@@ -0,0 +1,18 @@
+terraform import turso_database_migration.example database_name
+
+# The database name can be qualified by the organization it belongs to, and
+# followed by the tracking table if it is not the default.
+terraform import turso_database_migration.example organization_name/database_name:tracking_table
+
+# With Terraform 1.12 and later, the migrations can also be imported by their
+# identity in an import block. The organization and tracking table are
+# optional.
+#
+#   import {
+#     to = turso_database_migration.example
+#     identity = {
+#       organization   = "organization_name"
+#       database       = "database_name"
+#       tracking_table = "tracking_table"
+#     }
+#   }
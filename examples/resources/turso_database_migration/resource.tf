@@ -0,0 +1,9 @@
+# Applies the migrations in the migrations directory in order. New migrations
+# must sort after those already applied, and applied migrations must not be
+# changed.
+resource "turso_database_migration" "example" {
+  database        = "a-database"
+  migration_files = [
+    for file in sort(fileset(path.module, "migrations/*.sql")) : "${path.module}/${file}"
+  ]
+}
@@ -0,0 +1,15 @@
+terraform import turso_group.example_group group_name
+
+# The group name can be qualified by the organization it belongs to.
+terraform import turso_group.example_group organization_name/group_name
+
+# With Terraform 1.12 and later, the group can also be imported by its identity
+# in an import block. The organization is optional.
+#
+#   import {
+#     to = turso_group.example_group
+#     identity = {
+#       organization = "organization_name"
+#       name         = "group_name"
+#     }
+#   }
@@ -0,0 +1,8 @@
+# Invalidates every token of the group and its databases. Bumping the rotation
+# invalidates them again.
+resource "turso_token_invalidation" "example" {
+  group = "a-group"
+  triggers = {
+    rotation = "1"
+  }
+}
@@ -0,0 +1,152 @@
+// Package configgen generates Terraform configuration which imports the groups
+// and databases of an existing Turso organization, so that it can be brought
+// under management without writing each import block by hand.
+package configgen
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Generate lists the groups, databases and members of the organization and
+// writes an import block and a skeleton resource for each group and database
+// to w. Members cannot be managed by the provider, so they are listed in a
+// comment.
+func Generate(ctx context.Context, client *tursoclient.Client, organization string, w io.Writer) error {
+	groups, err := client.ListGroups(ctx, tursoclient.ListGroupsParams{OrganizationName: organization})
+	if err != nil {
+		return fmt.Errorf("listing groups: %w", err)
+	}
+	databases, err := client.ListDatabases(ctx, tursoclient.ListDatabasesParams{OrganizationName: organization})
+	if err != nil {
+		return fmt.Errorf("listing databases: %w", err)
+	}
+	members, err := client.ListOrganizationMembers(ctx, tursoclient.ListOrganizationMembersParams{OrganizationName: organization})
+	if err != nil {
+		return fmt.Errorf("listing members: %w", err)
+	}
+
+	slices.SortFunc(groups.Groups, func(a, b tursoclient.BaseGroup) int {
+		return strings.Compare(a.Name.Value, b.Name.Value)
+	})
+	// Schema databases come first, so that they are declared before the
+	// databases which refer to them.
+	slices.SortFunc(databases.Databases, func(a, b tursoclient.Database) int {
+		if a.IsSchema.Value != b.IsSchema.Value {
+			if a.IsSchema.Value {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Name.Value, b.Name.Value)
+	})
+
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+	appendComment(body, fmt.Sprintf("Generated from the Turso organization %s. Review the configuration, then run terraform plan to import it.", organization))
+
+	groupNames := map[string]bool{}
+	for _, group := range groups.Groups {
+		name := group.Name.Value
+		groupNames[name] = true
+
+		body.AppendNewline()
+		appendImport(body, "turso_group", name, organization+"/"+name)
+		body.AppendNewline()
+		resource := body.AppendNewBlock("resource", []string{"turso_group", label(name)}).Body()
+		resource.SetAttributeValue("name", cty.StringVal(name))
+		resource.SetAttributeValue("primary", cty.StringVal(group.Primary.Value))
+		locations := make([]cty.Value, len(group.Locations))
+		for i, location := range group.Locations {
+			locations[i] = cty.StringVal(location)
+		}
+		if len(locations) > 0 {
+			resource.SetAttributeValue("locations", cty.ListVal(locations))
+		}
+	}
+
+	databaseNames := map[string]bool{}
+	for _, db := range databases.Databases {
+		databaseNames[db.Name.Value] = true
+	}
+	for _, db := range databases.Databases {
+		name := db.Name.Value
+
+		body.AppendNewline()
+		appendImport(body, "turso_database", name, organization+"/"+name)
+		body.AppendNewline()
+		resource := body.AppendNewBlock("resource", []string{"turso_database", label(name)}).Body()
+		if group := db.Group.Value; groupNames[group] {
+			resource.SetAttributeTraversal("group", reference("turso_group", group, "name"))
+		} else {
+			resource.SetAttributeValue("group", cty.StringVal(group))
+		}
+		resource.SetAttributeValue("name", cty.StringVal(name))
+		if db.IsSchema.Value {
+			resource.SetAttributeValue("is_schema", cty.True)
+		}
+		if parent := db.Schema.Value; parent != "" {
+			if databaseNames[parent] {
+				resource.SetAttributeTraversal("schema", reference("turso_database", parent, "name"))
+			} else {
+				resource.SetAttributeValue("schema", cty.StringVal(parent))
+			}
+		}
+	}
+
+	if len(members.Members) > 0 {
+		body.AppendNewline()
+		appendComment(body, "The organization members are not managed by the provider:")
+		for _, member := range members.Members {
+			appendComment(body, fmt.Sprintf("  %s (%s)", member.Username.Value, member.Role.Value))
+		}
+	}
+
+	_, err = w.Write(hclwrite.Format(f.Bytes()))
+	return err
+}
+
+// label returns the Terraform resource name for a group or database. Names
+// may contain lowercase letters, numbers and dashes, but labels are written
+// with underscores and cannot start with a number.
+func label(name string) string {
+	label := strings.ReplaceAll(name, "-", "_")
+	if label != "" && label[0] >= '0' && label[0] <= '9' {
+		label = "_" + label
+	}
+	return label
+}
+
+// reference returns a reference to the attribute of the resource declared for
+// name.
+func reference(resourceType, name, attribute string) hcl.Traversal {
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: label(name)},
+		hcl.TraverseAttr{Name: attribute},
+	}
+}
+
+func appendImport(body *hclwrite.Body, resourceType, name, id string) {
+	block := body.AppendNewBlock("import", nil).Body()
+	block.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: label(name)},
+	})
+	block.SetAttributeValue("id", cty.StringVal(id))
+}
+
+func appendComment(body *hclwrite.Body, comment string) {
+	body.AppendUnstructuredTokens(hclwrite.Tokens{
+		{Type: hclsyntax.TokenComment, Bytes: []byte("# " + comment + "\n")},
+	})
+}
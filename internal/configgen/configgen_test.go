@@ -0,0 +1,171 @@
+package configgen
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+)
+
+// fakeOrganization serves the list operations of the Turso Platform API for a
+// single organization.
+type fakeOrganization struct {
+	tursoclient.UnimplementedHandler
+
+	groups    []tursoclient.BaseGroup
+	databases []tursoclient.Database
+	members   []tursoclient.Member
+}
+
+func (f *fakeOrganization) ListGroups(ctx context.Context, params tursoclient.ListGroupsParams) (*tursoclient.ListGroupsOK, error) {
+	return &tursoclient.ListGroupsOK{Groups: f.groups}, nil
+}
+
+func (f *fakeOrganization) ListDatabases(ctx context.Context, params tursoclient.ListDatabasesParams) (*tursoclient.ListDatabasesOK, error) {
+	return &tursoclient.ListDatabasesOK{Databases: f.databases}, nil
+}
+
+func (f *fakeOrganization) ListOrganizationMembers(ctx context.Context, params tursoclient.ListOrganizationMembersParams) (*tursoclient.ListOrganizationMembersOK, error) {
+	return &tursoclient.ListOrganizationMembersOK{Members: f.members}, nil
+}
+
+func TestGenerate(t *testing.T) {
+	fake := &fakeOrganization{
+		groups: []tursoclient.BaseGroup{
+			{
+				Name:      tursoclient.NewOptString("prod"),
+				Primary:   tursoclient.NewOptString("iad"),
+				Locations: []string{"iad", "ams"},
+			},
+			{
+				Name:      tursoclient.NewOptString("default"),
+				Primary:   tursoclient.NewOptString("ord"),
+				Locations: []string{"ord"},
+			},
+		},
+		databases: []tursoclient.Database{
+			{
+				Name:   tursoclient.NewOptString("tenant-1"),
+				Group:  tursoclient.NewOptString("prod"),
+				Schema: tursoclient.NewOptNilString("app-schema"),
+			},
+			{
+				Name:     tursoclient.NewOptString("app-schema"),
+				Group:    tursoclient.NewOptString("prod"),
+				IsSchema: tursoclient.NewOptBool(true),
+			},
+			{
+				Name:  tursoclient.NewOptString("2024-archive"),
+				Group: tursoclient.NewOptString("default"),
+			},
+		},
+		members: []tursoclient.Member{
+			{
+				Username: tursoclient.NewOptString("alice"),
+				Role:     tursoclient.NewOptMemberRole(tursoclient.MemberRoleOwner),
+			},
+		},
+	}
+	server, err := tursoclient.NewServer(fake)
+	if err != nil {
+		t.Fatalf("error creating fake server: %v", err)
+	}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	client, err := tursoclient.NewClient(httpServer.URL)
+	if err != nil {
+		t.Fatalf("error creating client: %v", err)
+	}
+
+	var out strings.Builder
+	if err := Generate(context.Background(), client, "my-org", &out); err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+
+	want := `# Generated from the Turso organization my-org. Review the configuration, then run terraform plan to import it.
+
+import {
+  to = turso_group.default
+  id = "my-org/default"
+}
+
+resource "turso_group" "default" {
+  name      = "default"
+  primary   = "ord"
+  locations = ["ord"]
+}
+
+import {
+  to = turso_group.prod
+  id = "my-org/prod"
+}
+
+resource "turso_group" "prod" {
+  name      = "prod"
+  primary   = "iad"
+  locations = ["iad", "ams"]
+}
+
+import {
+  to = turso_database.app_schema
+  id = "my-org/app-schema"
+}
+
+resource "turso_database" "app_schema" {
+  group     = turso_group.prod.name
+  name      = "app-schema"
+  is_schema = true
+}
+
+import {
+  to = turso_database._2024_archive
+  id = "my-org/2024-archive"
+}
+
+resource "turso_database" "_2024_archive" {
+  group = turso_group.default.name
+  name  = "2024-archive"
+}
+
+import {
+  to = turso_database.tenant_1
+  id = "my-org/tenant-1"
+}
+
+resource "turso_database" "tenant_1" {
+  group  = turso_group.prod.name
+  name   = "tenant-1"
+  schema = turso_database.app_schema.name
+}
+
+# The organization members are not managed by the provider:
+#   alice (owner)
+`
+	if got := out.String(); got != want {
+		t.Errorf("unexpected configuration:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestGenerate_ListError(t *testing.T) {
+	server, err := tursoclient.NewServer(tursoclient.UnimplementedHandler{})
+	if err != nil {
+		t.Fatalf("error creating fake server: %v", err)
+	}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	client, err := tursoclient.NewClient(httpServer.URL)
+	if err != nil {
+		t.Fatalf("error creating client: %v", err)
+	}
+
+	var out strings.Builder
+	err = Generate(context.Background(), client, "my-org", &out)
+	if err == nil || !strings.Contains(err.Error(), "listing groups") {
+		t.Errorf("expected an error listing groups, got %v", err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("expected no output, got:\n%s", out.String())
+	}
+}
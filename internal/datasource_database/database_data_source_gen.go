@@ -55,11 +55,21 @@ func DatabaseDataSourceSchema(ctx context.Context) schema.Schema {
 						Description:         "The DNS hostname used for client libSQL and HTTP connections.",
 						MarkdownDescription: "The DNS hostname used for client libSQL and HTTP connections.",
 					},
+					"http_url": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The URL used for client HTTP connections, which is the `hostname` with the `https` scheme.",
+						MarkdownDescription: "The URL used for client HTTP connections, which is the `hostname` with the `https` scheme.",
+					},
 					"is_schema": schema.BoolAttribute{
 						Computed:            true,
 						Description:         "If this database controls other child databases then this will be `true`. See [Multi-DB Schemas](/features/multi-db-schemas).",
 						MarkdownDescription: "If this database controls other child databases then this will be `true`. See [Multi-DB Schemas](/features/multi-db-schemas).",
 					},
+					"libsql_url": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The URL used for client libSQL connections, which is the `hostname` with the `libsql` scheme.",
+						MarkdownDescription: "The URL used for client libSQL connections, which is the `hostname` with the `libsql` scheme.",
+					},
 					"name": schema.StringAttribute{
 						Computed:            true,
 						Description:         "The database name, **unique** across your organization.",
@@ -264,6 +274,24 @@ func (t DatabaseType) ValueFromObject(ctx context.Context, in basetypes.ObjectVa
 			fmt.Sprintf(`hostname expected to be basetypes.StringValue, was: %T`, hostnameAttribute))
 	}
 
+	httpUrlAttribute, ok := attributes["http_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`http_url is missing from object`)
+
+		return nil, diags
+	}
+
+	httpUrlVal, ok := httpUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`http_url expected to be basetypes.StringValue, was: %T`, httpUrlAttribute))
+	}
+
 	isSchemaAttribute, ok := attributes["is_schema"]
 
 	if !ok {
@@ -282,6 +310,24 @@ func (t DatabaseType) ValueFromObject(ctx context.Context, in basetypes.ObjectVa
 			fmt.Sprintf(`is_schema expected to be basetypes.BoolValue, was: %T`, isSchemaAttribute))
 	}
 
+	libsqlUrlAttribute, ok := attributes["libsql_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`libsql_url is missing from object`)
+
+		return nil, diags
+	}
+
+	libsqlUrlVal, ok := libsqlUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`libsql_url expected to be basetypes.StringValue, was: %T`, libsqlUrlAttribute))
+	}
+
 	nameAttribute, ok := attributes["name"]
 
 	if !ok {
@@ -402,7 +448,9 @@ func (t DatabaseType) ValueFromObject(ctx context.Context, in basetypes.ObjectVa
 		DbId:          dbIdVal,
 		Group:         groupVal,
 		Hostname:      hostnameVal,
+		HttpUrl:       httpUrlVal,
 		IsSchema:      isSchemaVal,
+		LibsqlUrl:     libsqlUrlVal,
 		Name:          nameVal,
 		PrimaryRegion: primaryRegionVal,
 		Regions:       regionsVal,
@@ -602,6 +650,24 @@ func NewDatabaseValue(attributeTypes map[string]attr.Type, attributes map[string
 			fmt.Sprintf(`hostname expected to be basetypes.StringValue, was: %T`, hostnameAttribute))
 	}
 
+	httpUrlAttribute, ok := attributes["http_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`http_url is missing from object`)
+
+		return NewDatabaseValueUnknown(), diags
+	}
+
+	httpUrlVal, ok := httpUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`http_url expected to be basetypes.StringValue, was: %T`, httpUrlAttribute))
+	}
+
 	isSchemaAttribute, ok := attributes["is_schema"]
 
 	if !ok {
@@ -620,6 +686,24 @@ func NewDatabaseValue(attributeTypes map[string]attr.Type, attributes map[string
 			fmt.Sprintf(`is_schema expected to be basetypes.BoolValue, was: %T`, isSchemaAttribute))
 	}
 
+	libsqlUrlAttribute, ok := attributes["libsql_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`libsql_url is missing from object`)
+
+		return NewDatabaseValueUnknown(), diags
+	}
+
+	libsqlUrlVal, ok := libsqlUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`libsql_url expected to be basetypes.StringValue, was: %T`, libsqlUrlAttribute))
+	}
+
 	nameAttribute, ok := attributes["name"]
 
 	if !ok {
@@ -740,7 +824,9 @@ func NewDatabaseValue(attributeTypes map[string]attr.Type, attributes map[string
 		DbId:          dbIdVal,
 		Group:         groupVal,
 		Hostname:      hostnameVal,
+		HttpUrl:       httpUrlVal,
 		IsSchema:      isSchemaVal,
+		LibsqlUrl:     libsqlUrlVal,
 		Name:          nameVal,
 		PrimaryRegion: primaryRegionVal,
 		Regions:       regionsVal,
@@ -826,7 +912,9 @@ type DatabaseValue struct {
 	DbId          basetypes.StringValue `tfsdk:"db_id"`
 	Group         basetypes.StringValue `tfsdk:"group"`
 	Hostname      basetypes.StringValue `tfsdk:"hostname"`
+	HttpUrl       basetypes.StringValue `tfsdk:"http_url"`
 	IsSchema      basetypes.BoolValue   `tfsdk:"is_schema"`
+	LibsqlUrl     basetypes.StringValue `tfsdk:"libsql_url"`
 	Name          basetypes.StringValue `tfsdk:"name"`
 	PrimaryRegion basetypes.StringValue `tfsdk:"primary_region"`
 	Regions       basetypes.ListValue   `tfsdk:"regions"`
@@ -837,7 +925,7 @@ type DatabaseValue struct {
 }
 
 func (v DatabaseValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 14)
+	attrTypes := make(map[string]tftypes.Type, 16)
 
 	var val tftypes.Value
 	var err error
@@ -849,7 +937,9 @@ func (v DatabaseValue) ToTerraformValue(ctx context.Context) (tftypes.Value, err
 	attrTypes["db_id"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["group"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["hostname"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["http_url"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["is_schema"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["libsql_url"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["primary_region"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["regions"] = basetypes.ListType{
@@ -863,7 +953,7 @@ func (v DatabaseValue) ToTerraformValue(ctx context.Context) (tftypes.Value, err
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 14)
+		vals := make(map[string]tftypes.Value, 16)
 
 		val, err = v.AllowAttach.ToTerraformValue(ctx)
 
@@ -921,6 +1011,14 @@ func (v DatabaseValue) ToTerraformValue(ctx context.Context) (tftypes.Value, err
 
 		vals["hostname"] = val
 
+		val, err = v.HttpUrl.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["http_url"] = val
+
 		val, err = v.IsSchema.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -929,6 +1027,14 @@ func (v DatabaseValue) ToTerraformValue(ctx context.Context) (tftypes.Value, err
 
 		vals["is_schema"] = val
 
+		val, err = v.LibsqlUrl.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["libsql_url"] = val
+
 		val, err = v.Name.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -1019,7 +1125,9 @@ func (v DatabaseValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue
 			"db_id":          basetypes.StringType{},
 			"group":          basetypes.StringType{},
 			"hostname":       basetypes.StringType{},
+			"http_url":       basetypes.StringType{},
 			"is_schema":      basetypes.BoolType{},
+			"libsql_url":     basetypes.StringType{},
 			"name":           basetypes.StringType{},
 			"primary_region": basetypes.StringType{},
 			"regions": basetypes.ListType{
@@ -1039,7 +1147,9 @@ func (v DatabaseValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue
 		"db_id":          basetypes.StringType{},
 		"group":          basetypes.StringType{},
 		"hostname":       basetypes.StringType{},
+		"http_url":       basetypes.StringType{},
 		"is_schema":      basetypes.BoolType{},
+		"libsql_url":     basetypes.StringType{},
 		"name":           basetypes.StringType{},
 		"primary_region": basetypes.StringType{},
 		"regions": basetypes.ListType{
@@ -1068,7 +1178,9 @@ func (v DatabaseValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue
 			"db_id":          v.DbId,
 			"group":          v.Group,
 			"hostname":       v.Hostname,
+			"http_url":       v.HttpUrl,
 			"is_schema":      v.IsSchema,
+			"libsql_url":     v.LibsqlUrl,
 			"name":           v.Name,
 			"primary_region": v.PrimaryRegion,
 			"regions":        regionsVal,
@@ -1123,10 +1235,18 @@ func (v DatabaseValue) Equal(o attr.Value) bool {
 		return false
 	}
 
+	if !v.HttpUrl.Equal(other.HttpUrl) {
+		return false
+	}
+
 	if !v.IsSchema.Equal(other.IsSchema) {
 		return false
 	}
 
+	if !v.LibsqlUrl.Equal(other.LibsqlUrl) {
+		return false
+	}
+
 	if !v.Name.Equal(other.Name) {
 		return false
 	}
@@ -1171,7 +1291,9 @@ func (v DatabaseValue) AttributeTypes(ctx context.Context) map[string]attr.Type
 		"db_id":          basetypes.StringType{},
 		"group":          basetypes.StringType{},
 		"hostname":       basetypes.StringType{},
+		"http_url":       basetypes.StringType{},
 		"is_schema":      basetypes.BoolType{},
+		"libsql_url":     basetypes.StringType{},
 		"name":           basetypes.StringType{},
 		"primary_region": basetypes.StringType{},
 		"regions": basetypes.ListType{
@@ -31,6 +31,16 @@ func DatabaseInstancesDataSourceSchema(ctx context.Context) schema.Schema {
 							Description:         "The DNS hostname used for client libSQL and HTTP connections (specific to this instance only).",
 							MarkdownDescription: "The DNS hostname used for client libSQL and HTTP connections (specific to this instance only).",
 						},
+						"http_url": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The URL used for client HTTP connections (specific to this instance only).",
+							MarkdownDescription: "The URL used for client HTTP connections (specific to this instance only).",
+						},
+						"libsql_url": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The URL used for client libSQL connections (specific to this instance only).",
+							MarkdownDescription: "The URL used for client libSQL connections (specific to this instance only).",
+						},
 						"name": schema.StringAttribute{
 							Computed:            true,
 							Description:         "The name of the instance (location code).",
@@ -112,6 +122,42 @@ func (t InstancesType) ValueFromObject(ctx context.Context, in basetypes.ObjectV
 			fmt.Sprintf(`hostname expected to be basetypes.StringValue, was: %T`, hostnameAttribute))
 	}
 
+	httpUrlAttribute, ok := attributes["http_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`http_url is missing from object`)
+
+		return nil, diags
+	}
+
+	httpUrlVal, ok := httpUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`http_url expected to be basetypes.StringValue, was: %T`, httpUrlAttribute))
+	}
+
+	libsqlUrlAttribute, ok := attributes["libsql_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`libsql_url is missing from object`)
+
+		return nil, diags
+	}
+
+	libsqlUrlVal, ok := libsqlUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`libsql_url expected to be basetypes.StringValue, was: %T`, libsqlUrlAttribute))
+	}
+
 	nameAttribute, ok := attributes["name"]
 
 	if !ok {
@@ -190,6 +236,8 @@ func (t InstancesType) ValueFromObject(ctx context.Context, in basetypes.ObjectV
 
 	return InstancesValue{
 		Hostname:      hostnameVal,
+		HttpUrl:       httpUrlVal,
+		LibsqlUrl:     libsqlUrlVal,
 		Name:          nameVal,
 		Region:        regionVal,
 		InstancesType: typeVal,
@@ -279,6 +327,42 @@ func NewInstancesValue(attributeTypes map[string]attr.Type, attributes map[strin
 			fmt.Sprintf(`hostname expected to be basetypes.StringValue, was: %T`, hostnameAttribute))
 	}
 
+	httpUrlAttribute, ok := attributes["http_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`http_url is missing from object`)
+
+		return NewInstancesValueUnknown(), diags
+	}
+
+	httpUrlVal, ok := httpUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`http_url expected to be basetypes.StringValue, was: %T`, httpUrlAttribute))
+	}
+
+	libsqlUrlAttribute, ok := attributes["libsql_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`libsql_url is missing from object`)
+
+		return NewInstancesValueUnknown(), diags
+	}
+
+	libsqlUrlVal, ok := libsqlUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`libsql_url expected to be basetypes.StringValue, was: %T`, libsqlUrlAttribute))
+	}
+
 	nameAttribute, ok := attributes["name"]
 
 	if !ok {
@@ -357,6 +441,8 @@ func NewInstancesValue(attributeTypes map[string]attr.Type, attributes map[strin
 
 	return InstancesValue{
 		Hostname:      hostnameVal,
+		HttpUrl:       httpUrlVal,
+		LibsqlUrl:     libsqlUrlVal,
 		Name:          nameVal,
 		Region:        regionVal,
 		InstancesType: typeVal,
@@ -434,6 +520,8 @@ var _ basetypes.ObjectValuable = InstancesValue{}
 
 type InstancesValue struct {
 	Hostname      basetypes.StringValue `tfsdk:"hostname"`
+	HttpUrl       basetypes.StringValue `tfsdk:"http_url"`
+	LibsqlUrl     basetypes.StringValue `tfsdk:"libsql_url"`
 	Name          basetypes.StringValue `tfsdk:"name"`
 	Region        basetypes.StringValue `tfsdk:"region"`
 	InstancesType basetypes.StringValue `tfsdk:"type"`
@@ -442,12 +530,14 @@ type InstancesValue struct {
 }
 
 func (v InstancesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 5)
+	attrTypes := make(map[string]tftypes.Type, 7)
 
 	var val tftypes.Value
 	var err error
 
 	attrTypes["hostname"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["http_url"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["libsql_url"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["region"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["type"] = basetypes.StringType{}.TerraformType(ctx)
@@ -457,7 +547,7 @@ func (v InstancesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, er
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 5)
+		vals := make(map[string]tftypes.Value, 7)
 
 		val, err = v.Hostname.ToTerraformValue(ctx)
 
@@ -467,6 +557,22 @@ func (v InstancesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, er
 
 		vals["hostname"] = val
 
+		val, err = v.HttpUrl.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["http_url"] = val
+
+		val, err = v.LibsqlUrl.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["libsql_url"] = val
+
 		val, err = v.Name.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -529,11 +635,13 @@ func (v InstancesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValu
 	var diags diag.Diagnostics
 
 	attributeTypes := map[string]attr.Type{
-		"hostname": basetypes.StringType{},
-		"name":     basetypes.StringType{},
-		"region":   basetypes.StringType{},
-		"type":     basetypes.StringType{},
-		"uuid":     basetypes.StringType{},
+		"hostname":   basetypes.StringType{},
+		"http_url":   basetypes.StringType{},
+		"libsql_url": basetypes.StringType{},
+		"name":       basetypes.StringType{},
+		"region":     basetypes.StringType{},
+		"type":       basetypes.StringType{},
+		"uuid":       basetypes.StringType{},
 	}
 
 	if v.IsNull() {
@@ -547,11 +655,13 @@ func (v InstancesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValu
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"hostname": v.Hostname,
-			"name":     v.Name,
-			"region":   v.Region,
-			"type":     v.InstancesType,
-			"uuid":     v.Uuid,
+			"hostname":   v.Hostname,
+			"http_url":   v.HttpUrl,
+			"libsql_url": v.LibsqlUrl,
+			"name":       v.Name,
+			"region":     v.Region,
+			"type":       v.InstancesType,
+			"uuid":       v.Uuid,
 		})
 
 	return objVal, diags
@@ -576,6 +686,14 @@ func (v InstancesValue) Equal(o attr.Value) bool {
 		return false
 	}
 
+	if !v.HttpUrl.Equal(other.HttpUrl) {
+		return false
+	}
+
+	if !v.LibsqlUrl.Equal(other.LibsqlUrl) {
+		return false
+	}
+
 	if !v.Name.Equal(other.Name) {
 		return false
 	}
@@ -605,10 +723,12 @@ func (v InstancesValue) Type(ctx context.Context) attr.Type {
 
 func (v InstancesValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"hostname": basetypes.StringType{},
-		"name":     basetypes.StringType{},
-		"region":   basetypes.StringType{},
-		"type":     basetypes.StringType{},
-		"uuid":     basetypes.StringType{},
+		"hostname":   basetypes.StringType{},
+		"http_url":   basetypes.StringType{},
+		"libsql_url": basetypes.StringType{},
+		"name":       basetypes.StringType{},
+		"region":     basetypes.StringType{},
+		"type":       basetypes.StringType{},
+		"uuid":       basetypes.StringType{},
 	}
 }
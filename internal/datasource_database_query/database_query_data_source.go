@@ -0,0 +1,59 @@
+package datasource_database_query
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func DatabaseQueryDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"args": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "The values bound to the positional parameters (`?`) of the statement, as text.",
+				MarkdownDescription: "The values bound to the positional parameters (`?`) of the statement, as text.",
+			},
+			"columns": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "The names of the columns returned by the statement, in order.",
+				MarkdownDescription: "The names of the columns returned by the statement, in order.",
+			},
+			"id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the database.",
+				MarkdownDescription: "The name of the database.",
+			},
+			"instance": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The name of the database instance to query, as listed by the turso_database_instances data source. Defaults to the database hostname, which routes to the nearest instance.",
+				MarkdownDescription: "The name of the database instance to query, as listed by the `turso_database_instances` data source. Defaults to the database hostname, which routes to the nearest instance.",
+			},
+			"rows": schema.ListAttribute{
+				ElementType: types.MapType{
+					ElemType: types.StringType,
+				},
+				Computed:            true,
+				Description:         "The rows returned by the statement, each a map of column name to value. Values are converted to strings, blobs are base64 encoded and NULL values are null.",
+				MarkdownDescription: "The rows returned by the statement, each a map of column name to value. Values are converted to strings, blobs are base64 encoded and `NULL` values are null.",
+			},
+			"sql": schema.StringAttribute{
+				Required:            true,
+				Description:         "The SQL statement to run. Only a single SELECT statement, which may start with a WITH clause, is allowed, and it is run with a read-only token.",
+				MarkdownDescription: "The SQL statement to run. Only a single `SELECT` statement, which may start with a `WITH` clause, is allowed, and it is run with a read-only token.",
+			},
+		},
+	}
+}
+
+type DatabaseQueryModel struct {
+	Args     types.List   `tfsdk:"args"`
+	Columns  types.List   `tfsdk:"columns"`
+	Id       types.String `tfsdk:"id"`
+	Instance types.String `tfsdk:"instance"`
+	Rows     types.List   `tfsdk:"rows"`
+	Sql      types.String `tfsdk:"sql"`
+}
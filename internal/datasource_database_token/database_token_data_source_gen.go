@@ -26,6 +26,11 @@ func DatabaseTokenDataSourceSchema(ctx context.Context) schema.Schema {
 					),
 				},
 			},
+			"connection_string": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The libSQL URL of the database with the token embedded as the `authToken` query parameter, for clients which accept a single connection string.",
+				MarkdownDescription: "The libSQL URL of the database with the token embedded as the `authToken` query parameter, for clients which accept a single connection string.",
+			},
 			"expiration": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -47,8 +52,9 @@ func DatabaseTokenDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type DatabaseTokenModel struct {
-	Authorization types.String `tfsdk:"authorization"`
-	Expiration    types.String `tfsdk:"expiration"`
-	Id            types.String `tfsdk:"id"`
-	Jwt           types.String `tfsdk:"jwt"`
+	Authorization    types.String `tfsdk:"authorization"`
+	ConnectionString types.String `tfsdk:"connection_string"`
+	Expiration       types.String `tfsdk:"expiration"`
+	Id               types.String `tfsdk:"id"`
+	Jwt              types.String `tfsdk:"jwt"`
 }
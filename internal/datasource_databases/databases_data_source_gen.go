@@ -56,11 +56,21 @@ func DatabasesDataSourceSchema(ctx context.Context) schema.Schema {
 							Description:         "The DNS hostname used for client libSQL and HTTP connections.",
 							MarkdownDescription: "The DNS hostname used for client libSQL and HTTP connections.",
 						},
+						"http_url": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The URL used for client HTTP connections, which is the `hostname` with the `https` scheme.",
+							MarkdownDescription: "The URL used for client HTTP connections, which is the `hostname` with the `https` scheme.",
+						},
 						"is_schema": schema.BoolAttribute{
 							Computed:            true,
 							Description:         "If this database controls other child databases then this will be `true`. See [Multi-DB Schemas](/features/multi-db-schemas).",
 							MarkdownDescription: "If this database controls other child databases then this will be `true`. See [Multi-DB Schemas](/features/multi-db-schemas).",
 						},
+						"libsql_url": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The URL used for client libSQL connections, which is the `hostname` with the `libsql` scheme.",
+							MarkdownDescription: "The URL used for client libSQL connections, which is the `hostname` with the `libsql` scheme.",
+						},
 						"name": schema.StringAttribute{
 							Computed:            true,
 							Description:         "The database name, **unique** across your organization.",
@@ -274,6 +284,24 @@ func (t DatabasesType) ValueFromObject(ctx context.Context, in basetypes.ObjectV
 			fmt.Sprintf(`hostname expected to be basetypes.StringValue, was: %T`, hostnameAttribute))
 	}
 
+	httpUrlAttribute, ok := attributes["http_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`http_url is missing from object`)
+
+		return nil, diags
+	}
+
+	httpUrlVal, ok := httpUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`http_url expected to be basetypes.StringValue, was: %T`, httpUrlAttribute))
+	}
+
 	isSchemaAttribute, ok := attributes["is_schema"]
 
 	if !ok {
@@ -292,6 +320,24 @@ func (t DatabasesType) ValueFromObject(ctx context.Context, in basetypes.ObjectV
 			fmt.Sprintf(`is_schema expected to be basetypes.BoolValue, was: %T`, isSchemaAttribute))
 	}
 
+	libsqlUrlAttribute, ok := attributes["libsql_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`libsql_url is missing from object`)
+
+		return nil, diags
+	}
+
+	libsqlUrlVal, ok := libsqlUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`libsql_url expected to be basetypes.StringValue, was: %T`, libsqlUrlAttribute))
+	}
+
 	nameAttribute, ok := attributes["name"]
 
 	if !ok {
@@ -412,7 +458,9 @@ func (t DatabasesType) ValueFromObject(ctx context.Context, in basetypes.ObjectV
 		DbId:          dbIdVal,
 		Group:         groupVal,
 		Hostname:      hostnameVal,
+		HttpUrl:       httpUrlVal,
 		IsSchema:      isSchemaVal,
+		LibsqlUrl:     libsqlUrlVal,
 		Name:          nameVal,
 		PrimaryRegion: primaryRegionVal,
 		Regions:       regionsVal,
@@ -612,6 +660,24 @@ func NewDatabasesValue(attributeTypes map[string]attr.Type, attributes map[strin
 			fmt.Sprintf(`hostname expected to be basetypes.StringValue, was: %T`, hostnameAttribute))
 	}
 
+	httpUrlAttribute, ok := attributes["http_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`http_url is missing from object`)
+
+		return NewDatabasesValueUnknown(), diags
+	}
+
+	httpUrlVal, ok := httpUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`http_url expected to be basetypes.StringValue, was: %T`, httpUrlAttribute))
+	}
+
 	isSchemaAttribute, ok := attributes["is_schema"]
 
 	if !ok {
@@ -630,6 +696,24 @@ func NewDatabasesValue(attributeTypes map[string]attr.Type, attributes map[strin
 			fmt.Sprintf(`is_schema expected to be basetypes.BoolValue, was: %T`, isSchemaAttribute))
 	}
 
+	libsqlUrlAttribute, ok := attributes["libsql_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`libsql_url is missing from object`)
+
+		return NewDatabasesValueUnknown(), diags
+	}
+
+	libsqlUrlVal, ok := libsqlUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`libsql_url expected to be basetypes.StringValue, was: %T`, libsqlUrlAttribute))
+	}
+
 	nameAttribute, ok := attributes["name"]
 
 	if !ok {
@@ -750,7 +834,9 @@ func NewDatabasesValue(attributeTypes map[string]attr.Type, attributes map[strin
 		DbId:          dbIdVal,
 		Group:         groupVal,
 		Hostname:      hostnameVal,
+		HttpUrl:       httpUrlVal,
 		IsSchema:      isSchemaVal,
+		LibsqlUrl:     libsqlUrlVal,
 		Name:          nameVal,
 		PrimaryRegion: primaryRegionVal,
 		Regions:       regionsVal,
@@ -836,7 +922,9 @@ type DatabasesValue struct {
 	DbId          basetypes.StringValue `tfsdk:"db_id"`
 	Group         basetypes.StringValue `tfsdk:"group"`
 	Hostname      basetypes.StringValue `tfsdk:"hostname"`
+	HttpUrl       basetypes.StringValue `tfsdk:"http_url"`
 	IsSchema      basetypes.BoolValue   `tfsdk:"is_schema"`
+	LibsqlUrl     basetypes.StringValue `tfsdk:"libsql_url"`
 	Name          basetypes.StringValue `tfsdk:"name"`
 	PrimaryRegion basetypes.StringValue `tfsdk:"primary_region"`
 	Regions       basetypes.ListValue   `tfsdk:"regions"`
@@ -847,7 +935,7 @@ type DatabasesValue struct {
 }
 
 func (v DatabasesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 14)
+	attrTypes := make(map[string]tftypes.Type, 16)
 
 	var val tftypes.Value
 	var err error
@@ -859,7 +947,9 @@ func (v DatabasesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, er
 	attrTypes["db_id"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["group"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["hostname"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["http_url"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["is_schema"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["libsql_url"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["primary_region"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["regions"] = basetypes.ListType{
@@ -873,7 +963,7 @@ func (v DatabasesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, er
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 14)
+		vals := make(map[string]tftypes.Value, 16)
 
 		val, err = v.AllowAttach.ToTerraformValue(ctx)
 
@@ -931,6 +1021,14 @@ func (v DatabasesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, er
 
 		vals["hostname"] = val
 
+		val, err = v.HttpUrl.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["http_url"] = val
+
 		val, err = v.IsSchema.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -939,6 +1037,14 @@ func (v DatabasesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, er
 
 		vals["is_schema"] = val
 
+		val, err = v.LibsqlUrl.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["libsql_url"] = val
+
 		val, err = v.Name.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -1029,7 +1135,9 @@ func (v DatabasesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValu
 			"db_id":          basetypes.StringType{},
 			"group":          basetypes.StringType{},
 			"hostname":       basetypes.StringType{},
+			"http_url":       basetypes.StringType{},
 			"is_schema":      basetypes.BoolType{},
+			"libsql_url":     basetypes.StringType{},
 			"name":           basetypes.StringType{},
 			"primary_region": basetypes.StringType{},
 			"regions": basetypes.ListType{
@@ -1049,7 +1157,9 @@ func (v DatabasesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValu
 		"db_id":          basetypes.StringType{},
 		"group":          basetypes.StringType{},
 		"hostname":       basetypes.StringType{},
+		"http_url":       basetypes.StringType{},
 		"is_schema":      basetypes.BoolType{},
+		"libsql_url":     basetypes.StringType{},
 		"name":           basetypes.StringType{},
 		"primary_region": basetypes.StringType{},
 		"regions": basetypes.ListType{
@@ -1078,7 +1188,9 @@ func (v DatabasesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValu
 			"db_id":          v.DbId,
 			"group":          v.Group,
 			"hostname":       v.Hostname,
+			"http_url":       v.HttpUrl,
 			"is_schema":      v.IsSchema,
+			"libsql_url":     v.LibsqlUrl,
 			"name":           v.Name,
 			"primary_region": v.PrimaryRegion,
 			"regions":        regionsVal,
@@ -1133,10 +1245,18 @@ func (v DatabasesValue) Equal(o attr.Value) bool {
 		return false
 	}
 
+	if !v.HttpUrl.Equal(other.HttpUrl) {
+		return false
+	}
+
 	if !v.IsSchema.Equal(other.IsSchema) {
 		return false
 	}
 
+	if !v.LibsqlUrl.Equal(other.LibsqlUrl) {
+		return false
+	}
+
 	if !v.Name.Equal(other.Name) {
 		return false
 	}
@@ -1181,7 +1301,9 @@ func (v DatabasesValue) AttributeTypes(ctx context.Context) map[string]attr.Type
 		"db_id":          basetypes.StringType{},
 		"group":          basetypes.StringType{},
 		"hostname":       basetypes.StringType{},
+		"http_url":       basetypes.StringType{},
 		"is_schema":      basetypes.BoolType{},
+		"libsql_url":     basetypes.StringType{},
 		"name":           basetypes.StringType{},
 		"primary_region": basetypes.StringType{},
 		"regions": basetypes.ListType{
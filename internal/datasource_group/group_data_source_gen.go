@@ -25,6 +25,11 @@ func GroupDataSourceSchema(ctx context.Context) schema.Schema {
 						Description:         "Groups on the free tier get archived after some inactivity.",
 						MarkdownDescription: "Groups on the free tier get archived after some inactivity.",
 					},
+					"extensions": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The extensions enabled for the databases in the group, which is all when every extension is enabled. Null when no extensions are enabled, or when the API does not report them.",
+						MarkdownDescription: "The extensions enabled for the databases in the group, which is `all` when every extension is enabled. Null when no extensions are enabled, or when the API does not report them.",
+					},
 					"locations": schema.ListAttribute{
 						ElementType:         types.StringType,
 						Computed:            true,
@@ -116,6 +121,24 @@ func (t GroupType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue
 			fmt.Sprintf(`archived expected to be basetypes.BoolValue, was: %T`, archivedAttribute))
 	}
 
+	extensionsAttribute, ok := attributes["extensions"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`extensions is missing from object`)
+
+		return nil, diags
+	}
+
+	extensionsVal, ok := extensionsAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`extensions expected to be basetypes.StringValue, was: %T`, extensionsAttribute))
+	}
+
 	locationsAttribute, ok := attributes["locations"]
 
 	if !ok {
@@ -211,13 +234,14 @@ func (t GroupType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue
 	}
 
 	return GroupValue{
-		Archived:  archivedVal,
-		Locations: locationsVal,
-		Name:      nameVal,
-		Primary:   primaryVal,
-		Uuid:      uuidVal,
-		Version:   versionVal,
-		state:     attr.ValueStateKnown,
+		Archived:   archivedVal,
+		Extensions: extensionsVal,
+		Locations:  locationsVal,
+		Name:       nameVal,
+		Primary:    primaryVal,
+		Uuid:       uuidVal,
+		Version:    versionVal,
+		state:      attr.ValueStateKnown,
 	}, diags
 }
 
@@ -302,6 +326,24 @@ func NewGroupValue(attributeTypes map[string]attr.Type, attributes map[string]at
 			fmt.Sprintf(`archived expected to be basetypes.BoolValue, was: %T`, archivedAttribute))
 	}
 
+	extensionsAttribute, ok := attributes["extensions"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`extensions is missing from object`)
+
+		return NewGroupValueUnknown(), diags
+	}
+
+	extensionsVal, ok := extensionsAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`extensions expected to be basetypes.StringValue, was: %T`, extensionsAttribute))
+	}
+
 	locationsAttribute, ok := attributes["locations"]
 
 	if !ok {
@@ -397,13 +439,14 @@ func NewGroupValue(attributeTypes map[string]attr.Type, attributes map[string]at
 	}
 
 	return GroupValue{
-		Archived:  archivedVal,
-		Locations: locationsVal,
-		Name:      nameVal,
-		Primary:   primaryVal,
-		Uuid:      uuidVal,
-		Version:   versionVal,
-		state:     attr.ValueStateKnown,
+		Archived:   archivedVal,
+		Extensions: extensionsVal,
+		Locations:  locationsVal,
+		Name:       nameVal,
+		Primary:    primaryVal,
+		Uuid:       uuidVal,
+		Version:    versionVal,
+		state:      attr.ValueStateKnown,
 	}, diags
 }
 
@@ -475,22 +518,24 @@ func (t GroupType) ValueType(ctx context.Context) attr.Value {
 var _ basetypes.ObjectValuable = GroupValue{}
 
 type GroupValue struct {
-	Archived  basetypes.BoolValue   `tfsdk:"archived"`
-	Locations basetypes.ListValue   `tfsdk:"locations"`
-	Name      basetypes.StringValue `tfsdk:"name"`
-	Primary   basetypes.StringValue `tfsdk:"primary"`
-	Uuid      basetypes.StringValue `tfsdk:"uuid"`
-	Version   basetypes.StringValue `tfsdk:"version"`
-	state     attr.ValueState
+	Archived   basetypes.BoolValue   `tfsdk:"archived"`
+	Extensions basetypes.StringValue `tfsdk:"extensions"`
+	Locations  basetypes.ListValue   `tfsdk:"locations"`
+	Name       basetypes.StringValue `tfsdk:"name"`
+	Primary    basetypes.StringValue `tfsdk:"primary"`
+	Uuid       basetypes.StringValue `tfsdk:"uuid"`
+	Version    basetypes.StringValue `tfsdk:"version"`
+	state      attr.ValueState
 }
 
 func (v GroupValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 6)
+	attrTypes := make(map[string]tftypes.Type, 7)
 
 	var val tftypes.Value
 	var err error
 
 	attrTypes["archived"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["extensions"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["locations"] = basetypes.ListType{
 		ElemType: types.StringType,
 	}.TerraformType(ctx)
@@ -503,7 +548,7 @@ func (v GroupValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error)
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 6)
+		vals := make(map[string]tftypes.Value, 7)
 
 		val, err = v.Archived.ToTerraformValue(ctx)
 
@@ -513,6 +558,14 @@ func (v GroupValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error)
 
 		vals["archived"] = val
 
+		val, err = v.Extensions.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["extensions"] = val
+
 		val, err = v.Locations.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -588,7 +641,8 @@ func (v GroupValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, d
 
 	if d.HasError() {
 		return types.ObjectUnknown(map[string]attr.Type{
-			"archived": basetypes.BoolType{},
+			"archived":   basetypes.BoolType{},
+			"extensions": basetypes.StringType{},
 			"locations": basetypes.ListType{
 				ElemType: types.StringType,
 			},
@@ -600,7 +654,8 @@ func (v GroupValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, d
 	}
 
 	attributeTypes := map[string]attr.Type{
-		"archived": basetypes.BoolType{},
+		"archived":   basetypes.BoolType{},
+		"extensions": basetypes.StringType{},
 		"locations": basetypes.ListType{
 			ElemType: types.StringType,
 		},
@@ -621,12 +676,13 @@ func (v GroupValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, d
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"archived":  v.Archived,
-			"locations": locationsVal,
-			"name":      v.Name,
-			"primary":   v.Primary,
-			"uuid":      v.Uuid,
-			"version":   v.Version,
+			"archived":   v.Archived,
+			"extensions": v.Extensions,
+			"locations":  locationsVal,
+			"name":       v.Name,
+			"primary":    v.Primary,
+			"uuid":       v.Uuid,
+			"version":    v.Version,
 		})
 
 	return objVal, diags
@@ -651,6 +707,10 @@ func (v GroupValue) Equal(o attr.Value) bool {
 		return false
 	}
 
+	if !v.Extensions.Equal(other.Extensions) {
+		return false
+	}
+
 	if !v.Locations.Equal(other.Locations) {
 		return false
 	}
@@ -684,7 +744,8 @@ func (v GroupValue) Type(ctx context.Context) attr.Type {
 
 func (v GroupValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"archived": basetypes.BoolType{},
+		"archived":   basetypes.BoolType{},
+		"extensions": basetypes.StringType{},
 		"locations": basetypes.ListType{
 			ElemType: types.StringType,
 		},
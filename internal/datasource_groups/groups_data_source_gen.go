@@ -26,6 +26,11 @@ func GroupsDataSourceSchema(ctx context.Context) schema.Schema {
 							Description:         "Groups on the free tier get archived after some inactivity.",
 							MarkdownDescription: "Groups on the free tier get archived after some inactivity.",
 						},
+						"extensions": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The extensions enabled for the databases in the group, which is all when every extension is enabled. Null when no extensions are enabled, or when the API does not report them.",
+							MarkdownDescription: "The extensions enabled for the databases in the group, which is `all` when every extension is enabled. Null when no extensions are enabled, or when the API does not report them.",
+						},
 						"locations": schema.ListAttribute{
 							ElementType:         types.StringType,
 							Computed:            true,
@@ -112,6 +117,24 @@ func (t GroupsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValu
 			fmt.Sprintf(`archived expected to be basetypes.BoolValue, was: %T`, archivedAttribute))
 	}
 
+	extensionsAttribute, ok := attributes["extensions"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`extensions is missing from object`)
+
+		return nil, diags
+	}
+
+	extensionsVal, ok := extensionsAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`extensions expected to be basetypes.StringValue, was: %T`, extensionsAttribute))
+	}
+
 	locationsAttribute, ok := attributes["locations"]
 
 	if !ok {
@@ -207,13 +230,14 @@ func (t GroupsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValu
 	}
 
 	return GroupsValue{
-		Archived:  archivedVal,
-		Locations: locationsVal,
-		Name:      nameVal,
-		Primary:   primaryVal,
-		Uuid:      uuidVal,
-		Version:   versionVal,
-		state:     attr.ValueStateKnown,
+		Archived:   archivedVal,
+		Extensions: extensionsVal,
+		Locations:  locationsVal,
+		Name:       nameVal,
+		Primary:    primaryVal,
+		Uuid:       uuidVal,
+		Version:    versionVal,
+		state:      attr.ValueStateKnown,
 	}, diags
 }
 
@@ -298,6 +322,24 @@ func NewGroupsValue(attributeTypes map[string]attr.Type, attributes map[string]a
 			fmt.Sprintf(`archived expected to be basetypes.BoolValue, was: %T`, archivedAttribute))
 	}
 
+	extensionsAttribute, ok := attributes["extensions"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`extensions is missing from object`)
+
+		return NewGroupsValueUnknown(), diags
+	}
+
+	extensionsVal, ok := extensionsAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`extensions expected to be basetypes.StringValue, was: %T`, extensionsAttribute))
+	}
+
 	locationsAttribute, ok := attributes["locations"]
 
 	if !ok {
@@ -393,13 +435,14 @@ func NewGroupsValue(attributeTypes map[string]attr.Type, attributes map[string]a
 	}
 
 	return GroupsValue{
-		Archived:  archivedVal,
-		Locations: locationsVal,
-		Name:      nameVal,
-		Primary:   primaryVal,
-		Uuid:      uuidVal,
-		Version:   versionVal,
-		state:     attr.ValueStateKnown,
+		Archived:   archivedVal,
+		Extensions: extensionsVal,
+		Locations:  locationsVal,
+		Name:       nameVal,
+		Primary:    primaryVal,
+		Uuid:       uuidVal,
+		Version:    versionVal,
+		state:      attr.ValueStateKnown,
 	}, diags
 }
 
@@ -471,22 +514,24 @@ func (t GroupsType) ValueType(ctx context.Context) attr.Value {
 var _ basetypes.ObjectValuable = GroupsValue{}
 
 type GroupsValue struct {
-	Archived  basetypes.BoolValue   `tfsdk:"archived"`
-	Locations basetypes.ListValue   `tfsdk:"locations"`
-	Name      basetypes.StringValue `tfsdk:"name"`
-	Primary   basetypes.StringValue `tfsdk:"primary"`
-	Uuid      basetypes.StringValue `tfsdk:"uuid"`
-	Version   basetypes.StringValue `tfsdk:"version"`
-	state     attr.ValueState
+	Archived   basetypes.BoolValue   `tfsdk:"archived"`
+	Extensions basetypes.StringValue `tfsdk:"extensions"`
+	Locations  basetypes.ListValue   `tfsdk:"locations"`
+	Name       basetypes.StringValue `tfsdk:"name"`
+	Primary    basetypes.StringValue `tfsdk:"primary"`
+	Uuid       basetypes.StringValue `tfsdk:"uuid"`
+	Version    basetypes.StringValue `tfsdk:"version"`
+	state      attr.ValueState
 }
 
 func (v GroupsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 6)
+	attrTypes := make(map[string]tftypes.Type, 7)
 
 	var val tftypes.Value
 	var err error
 
 	attrTypes["archived"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["extensions"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["locations"] = basetypes.ListType{
 		ElemType: types.StringType,
 	}.TerraformType(ctx)
@@ -499,7 +544,7 @@ func (v GroupsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 6)
+		vals := make(map[string]tftypes.Value, 7)
 
 		val, err = v.Archived.ToTerraformValue(ctx)
 
@@ -509,6 +554,14 @@ func (v GroupsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error
 
 		vals["archived"] = val
 
+		val, err = v.Extensions.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["extensions"] = val
+
 		val, err = v.Locations.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -584,7 +637,8 @@ func (v GroupsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue,
 
 	if d.HasError() {
 		return types.ObjectUnknown(map[string]attr.Type{
-			"archived": basetypes.BoolType{},
+			"archived":   basetypes.BoolType{},
+			"extensions": basetypes.StringType{},
 			"locations": basetypes.ListType{
 				ElemType: types.StringType,
 			},
@@ -596,7 +650,8 @@ func (v GroupsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue,
 	}
 
 	attributeTypes := map[string]attr.Type{
-		"archived": basetypes.BoolType{},
+		"archived":   basetypes.BoolType{},
+		"extensions": basetypes.StringType{},
 		"locations": basetypes.ListType{
 			ElemType: types.StringType,
 		},
@@ -617,12 +672,13 @@ func (v GroupsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue,
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"archived":  v.Archived,
-			"locations": locationsVal,
-			"name":      v.Name,
-			"primary":   v.Primary,
-			"uuid":      v.Uuid,
-			"version":   v.Version,
+			"archived":   v.Archived,
+			"extensions": v.Extensions,
+			"locations":  locationsVal,
+			"name":       v.Name,
+			"primary":    v.Primary,
+			"uuid":       v.Uuid,
+			"version":    v.Version,
 		})
 
 	return objVal, diags
@@ -647,6 +703,10 @@ func (v GroupsValue) Equal(o attr.Value) bool {
 		return false
 	}
 
+	if !v.Extensions.Equal(other.Extensions) {
+		return false
+	}
+
 	if !v.Locations.Equal(other.Locations) {
 		return false
 	}
@@ -680,7 +740,8 @@ func (v GroupsValue) Type(ctx context.Context) attr.Type {
 
 func (v GroupsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"archived": basetypes.BoolType{},
+		"archived":   basetypes.BoolType{},
+		"extensions": basetypes.StringType{},
 		"locations": basetypes.ListType{
 			ElemType: types.StringType,
 		},
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ action.Action = &InvalidateDatabaseTokensAction{}
+var _ action.ActionWithConfigure = &InvalidateDatabaseTokensAction{}
+
+func NewInvalidateDatabaseTokensAction() action.Action {
+	return &InvalidateDatabaseTokensAction{}
+}
+
+// InvalidateDatabaseTokensAction invalidates every token of a database.
+type InvalidateDatabaseTokensAction struct {
+	*tursoProviderConfig
+}
+
+type invalidateDatabaseTokensModel struct {
+	Database types.String `tfsdk:"database"`
+}
+
+func (a *InvalidateDatabaseTokensAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invalidate_database_tokens"
+}
+
+func (a *InvalidateDatabaseTokensAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Invalidates all tokens of a database, such as after a token was leaked. Tokens created afterwards are valid.",
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "The name of the database.",
+				Required:            true,
+				Validators:          nameValidators(),
+			},
+		},
+	}
+}
+
+func (a *InvalidateDatabaseTokensAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*tursoProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *tursoProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.tursoProviderConfig = config
+}
+
+func (a *InvalidateDatabaseTokensAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data invalidateDatabaseTokensModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Database.ValueString()
+	sendProgress(resp, "Invalidating the tokens of database %q", name)
+	if err := a.invalidateDatabaseTokens(ctx, name); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to invalidate the tokens of database %q, got error: %s", name, err))
+		return
+	}
+	sendProgress(resp, "Invalidated the tokens of database %q", name)
+}
+
+// invalidateDatabaseTokens invalidates every token of the database.
+func (r *tursoProviderConfig) invalidateDatabaseTokens(ctx context.Context, name string) error {
+	tflog.Debug(ctx, "invalidating database tokens", map[string]interface{}{
+		"database": name,
+	})
+	res, err := r.Client.InvalidateDatabaseTokens(ctx, tursoclient.InvalidateDatabaseTokensParams{
+		OrganizationName: r.Organization,
+		DatabaseName:     name,
+	})
+	if err != nil {
+		return err
+	}
+	if _, ok := res.(*tursoclient.InvalidateDatabaseTokensOK); !ok {
+		return fmt.Errorf("unexpected response: %+v", res)
+	}
+	r.tokens.forget()
+	return nil
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// invokeAction invokes a with the config, a model of its action block, and
+// returns the progress messages it sent along with its diagnostics.
+func invokeAction(t *testing.T, ctx context.Context, a action.Action, config any) ([]string, diag.Diagnostics) {
+	t.Helper()
+
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("error getting schema: %v", schemaResp.Diagnostics)
+	}
+	configState := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := configState.Set(ctx, config); diags.HasError() {
+		t.Fatalf("error encoding config: %v", diags)
+	}
+
+	var progress []string
+	resp := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			progress = append(progress, event.Message)
+		},
+	}
+	a.Invoke(ctx, action.InvokeRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw}}, &resp)
+	return progress, resp.Diagnostics
+}
+
+func TestInvalidateDatabaseTokensActionInvoke(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	config := fake.start(t)
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: config}, "test", "sjc", "sjc")
+	fake.addDatabase("app", "test", "app-test-org.turso.io")
+
+	a := &InvalidateDatabaseTokensAction{tursoProviderConfig: config}
+	progress, diags := invokeAction(t, ctx, a, invalidateDatabaseTokensModel{Database: types.StringValue("app")})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if calls := fake.callsTo("InvalidateDatabaseTokens"); !slices.Equal(calls, []string{"InvalidateDatabaseTokens app"}) {
+		t.Errorf("expected the tokens of app to be invalidated, got %v", calls)
+	}
+	want := []string{
+		`Invalidating the tokens of database "app"`,
+		`Invalidated the tokens of database "app"`,
+	}
+	if !slices.Equal(progress, want) {
+		t.Errorf("expected progress %q, got %q", want, progress)
+	}
+}
+
+func TestInvalidateDatabaseTokensActionInvoke_NotFound(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	config := fake.start(t)
+
+	a := &InvalidateDatabaseTokensAction{tursoProviderConfig: config}
+	progress, diags := invokeAction(t, ctx, a, invalidateDatabaseTokensModel{Database: types.StringValue("missing")})
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), `"missing"`) {
+		t.Fatalf("expected an error for the missing database, got %v", diags)
+	}
+	if len(progress) != 1 {
+		t.Errorf("expected no progress after the failure, got %q", progress)
+	}
+}
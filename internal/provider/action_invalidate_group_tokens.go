@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ action.Action = &InvalidateGroupTokensAction{}
+var _ action.ActionWithConfigure = &InvalidateGroupTokensAction{}
+
+func NewInvalidateGroupTokensAction() action.Action {
+	return &InvalidateGroupTokensAction{}
+}
+
+// InvalidateGroupTokensAction invalidates every token of a group, including
+// the tokens of its databases.
+type InvalidateGroupTokensAction struct {
+	*tursoProviderConfig
+}
+
+type invalidateGroupTokensModel struct {
+	Group types.String `tfsdk:"group"`
+}
+
+func (a *InvalidateGroupTokensAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invalidate_group_tokens"
+}
+
+func (a *InvalidateGroupTokensAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Invalidates all tokens of a group and of the databases in it, such as after a token was leaked. Tokens created afterwards are valid.",
+		Attributes: map[string]schema.Attribute{
+			"group": schema.StringAttribute{
+				MarkdownDescription: "The name of the group.",
+				Required:            true,
+				Validators:          nameValidators(),
+			},
+		},
+	}
+}
+
+func (a *InvalidateGroupTokensAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*tursoProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *tursoProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.tursoProviderConfig = config
+}
+
+func (a *InvalidateGroupTokensAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data invalidateGroupTokensModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Group.ValueString()
+	sendProgress(resp, "Invalidating the tokens of group %q", name)
+	if err := a.invalidateGroupTokens(ctx, name); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to invalidate the tokens of group %q, got error: %s", name, err))
+		return
+	}
+	sendProgress(resp, "Invalidated the tokens of group %q", name)
+}
+
+// invalidateGroupTokens invalidates every token of the group.
+func (r *tursoProviderConfig) invalidateGroupTokens(ctx context.Context, name string) error {
+	tflog.Debug(ctx, "invalidating group tokens", map[string]interface{}{
+		"group": name,
+	})
+	res, err := r.Client.InvalidateGroupTokens(ctx, tursoclient.InvalidateGroupTokensParams{
+		OrganizationName: r.Organization,
+		GroupName:        name,
+	})
+	if err != nil {
+		return err
+	}
+	if _, ok := res.(*tursoclient.InvalidateGroupTokensOK); !ok {
+		return fmt.Errorf("unexpected response: %+v", res)
+	}
+	r.tokens.forget()
+	return nil
+}
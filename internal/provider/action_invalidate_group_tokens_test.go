@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestInvalidateGroupTokensActionInvoke(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	config := fake.start(t)
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: config}, "test", "sjc", "sjc")
+
+	a := &InvalidateGroupTokensAction{tursoProviderConfig: config}
+	progress, diags := invokeAction(t, ctx, a, invalidateGroupTokensModel{Group: types.StringValue("test")})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if calls := fake.callsTo("InvalidateGroupTokens"); !slices.Equal(calls, []string{"InvalidateGroupTokens test"}) {
+		t.Errorf("expected the tokens of test to be invalidated, got %v", calls)
+	}
+	want := []string{
+		`Invalidating the tokens of group "test"`,
+		`Invalidated the tokens of group "test"`,
+	}
+	if !slices.Equal(progress, want) {
+		t.Errorf("expected progress %q, got %q", want, progress)
+	}
+}
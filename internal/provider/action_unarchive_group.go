@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.Action = &UnarchiveGroupAction{}
+var _ action.ActionWithConfigure = &UnarchiveGroupAction{}
+
+func NewUnarchiveGroupAction() action.Action {
+	return &UnarchiveGroupAction{}
+}
+
+// UnarchiveGroupAction unarchives a group that Turso archived for inactivity.
+type UnarchiveGroupAction struct {
+	*tursoProviderConfig
+}
+
+type unarchiveGroupModel struct {
+	Group        types.String `tfsdk:"group"`
+	WaitForReady types.Bool   `tfsdk:"wait_for_ready"`
+}
+
+func (a *UnarchiveGroupAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unarchive_group"
+}
+
+func (a *UnarchiveGroupAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Unarchives a group that was archived for inactivity, so that its databases accept connections again.",
+		Attributes: map[string]schema.Attribute{
+			"group": schema.StringAttribute{
+				MarkdownDescription: "The name of the group.",
+				Required:            true,
+				Validators:          nameValidators(),
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				MarkdownDescription: "Wait after unarchiving until every database in the group answers health checks.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *UnarchiveGroupAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*tursoProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *tursoProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.tursoProviderConfig = config
+}
+
+func (a *UnarchiveGroupAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data unarchiveGroupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Group.ValueString()
+	sendProgress(resp, "Unarchiving group %q", name)
+	res, err := a.Client.UnarchiveGroup(ctx, tursoclient.UnarchiveGroupParams{
+		OrganizationName: a.Organization,
+		GroupName:        name,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unarchive group %q, got error: %s", name, err))
+		return
+	}
+	if _, ok := res.(*tursoclient.UnarchiveGroupOK); !ok {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unarchive group %q, got unexpected response: %+v", name, res))
+		return
+	}
+	sendProgress(resp, "Unarchived group %q", name)
+
+	if data.WaitForReady.ValueBool() {
+		sendProgress(resp, "Waiting for the databases of group %q to be ready", name)
+		resp.Diagnostics.Append(a.waitForGroupReady(ctx, name)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		sendProgress(resp, "Group %q is ready", name)
+	}
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnarchiveGroupActionInvoke(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	config := fake.start(t)
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: config}, "test", "sjc", "sjc")
+	fake.groups["test"].Archived = tursoclient.NewOptBool(true)
+
+	a := &UnarchiveGroupAction{tursoProviderConfig: config}
+	progress, diags := invokeAction(t, ctx, a, unarchiveGroupModel{
+		Group:        types.StringValue("test"),
+		WaitForReady: types.BoolValue(true),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if fake.groups["test"].Archived.Value {
+		t.Errorf("expected the group to be unarchived")
+	}
+	want := []string{
+		`Unarchiving group "test"`,
+		`Unarchived group "test"`,
+		`Waiting for the databases of group "test" to be ready`,
+		`Group "test" is ready`,
+	}
+	if !slices.Equal(progress, want) {
+		t.Errorf("expected progress %q, got %q", want, progress)
+	}
+}
+
+func TestUnarchiveGroupActionInvoke_NotFound(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	config := fake.start(t)
+
+	a := &UnarchiveGroupAction{tursoProviderConfig: config}
+	_, diags := invokeAction(t, ctx, a, unarchiveGroupModel{
+		Group:        types.StringValue("missing"),
+		WaitForReady: types.BoolNull(),
+	})
+	if !diags.HasError() {
+		t.Fatalf("expected an error for the missing group")
+	}
+}
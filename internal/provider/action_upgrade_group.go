@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.Action = &UpgradeGroupAction{}
+var _ action.ActionWithConfigure = &UpgradeGroupAction{}
+
+func NewUpgradeGroupAction() action.Action {
+	return &UpgradeGroupAction{}
+}
+
+// UpgradeGroupAction upgrades the databases of a group to the latest libSQL
+// server version.
+type UpgradeGroupAction struct {
+	*tursoProviderConfig
+}
+
+type upgradeGroupModel struct {
+	Group        types.String `tfsdk:"group"`
+	WaitForReady types.Bool   `tfsdk:"wait_for_ready"`
+}
+
+func (a *UpgradeGroupAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_upgrade_group"
+}
+
+func (a *UpgradeGroupAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Upgrades the databases of a group to the latest libSQL server version. The databases are briefly unavailable while they restart.",
+		Attributes: map[string]schema.Attribute{
+			"group": schema.StringAttribute{
+				MarkdownDescription: "The name of the group.",
+				Required:            true,
+				Validators:          nameValidators(),
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				MarkdownDescription: "Wait after upgrading until every database in the group answers health checks.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *UpgradeGroupAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*tursoProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *tursoProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.tursoProviderConfig = config
+}
+
+func (a *UpgradeGroupAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data upgradeGroupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Group.ValueString()
+	group, diags := a.readGroup(ctx, name)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sendProgress(resp, "Upgrading group %q from version %s", name, group.Version.Value)
+	res, err := a.Client.UpdateGroupDatabases(ctx, tursoclient.UpdateGroupDatabasesParams{
+		OrganizationName: a.Organization,
+		GroupName:        name,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upgrade group %q, got error: %s", name, err))
+		return
+	}
+	if _, ok := res.(*tursoclient.UpdateGroupDatabasesOK); !ok {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upgrade group %q, got unexpected response: %+v", name, res))
+		return
+	}
+
+	if data.WaitForReady.ValueBool() {
+		sendProgress(resp, "Waiting for the databases of group %q to be ready", name)
+		resp.Diagnostics.Append(a.waitForGroupReady(ctx, name)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	group, diags = a.readGroup(ctx, name)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sendProgress(resp, "Upgraded group %q to version %s", name, group.Version.Value)
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUpgradeGroupActionInvoke(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	config := fake.start(t)
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: config}, "test", "sjc", "sjc")
+
+	a := &UpgradeGroupAction{tursoProviderConfig: config}
+	progress, diags := invokeAction(t, ctx, a, upgradeGroupModel{
+		Group:        types.StringValue("test"),
+		WaitForReady: types.BoolNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if calls := fake.callsTo("UpdateGroupDatabases"); !slices.Equal(calls, []string{"UpdateGroupDatabases test"}) {
+		t.Errorf("expected the group to be upgraded, got %v", calls)
+	}
+	want := []string{
+		`Upgrading group "test" from version v0.24.0`,
+		`Upgraded group "test" to version v0.25.0`,
+	}
+	if !slices.Equal(progress, want) {
+		t.Errorf("expected progress %q, got %q", want, progress)
+	}
+}
@@ -0,0 +1,540 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/resource_database"
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// databaseMoveKey is the private state key marking a replacement which moves
+// the database to another group. The original database is kept by Delete and
+// moved by Create, see createMovedDatabase.
+const databaseMoveKey = "moving"
+
+// databaseMove is the move recorded under databaseMoveKey. It holds the seed
+// attributes and seeded_from record of the original database, which only
+// apply when a database is created and so are kept by the moved database.
+type databaseMove struct {
+	Seed       map[string]*string `json:"seed,omitempty"`
+	SeededFrom map[string]*string `json:"seeded_from,omitempty"`
+}
+
+// listTablesSQL lists the tables of a database, other than the internal tables
+// of SQLite.
+const listTablesSQL = `SELECT name FROM sqlite_schema WHERE type = 'table' AND name NOT LIKE 'sqlite\_%' ESCAPE '\' ORDER BY name`
+
+// moveStagingName returns the name of the temporary copy used to move the
+// database to another group. Database names are unique across the
+// organization, so the database cannot be copied to its new group under its
+// own name until the original is deleted.
+func moveStagingName(name string) string {
+	const suffix = "-moving"
+	if len(name)+len(suffix) > maxNameLength {
+		name = name[:maxNameLength-len(suffix)]
+	}
+	return name + suffix
+}
+
+func stringPointer(v types.String) *string {
+	if !isProvided(v) {
+		return nil
+	}
+	s := v.ValueString()
+	return &s
+}
+
+func stringFromPointer(s *string) types.String {
+	if s == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(*s)
+}
+
+// readDatabaseMove returns the move recorded in the private state, and whether
+// one is recorded.
+func readDatabaseMove(ctx context.Context, private privateState) (databaseMove, bool, diag.Diagnostics) {
+	var move databaseMove
+	value, diags := private.GetKey(ctx, databaseMoveKey)
+	if diags.HasError() || len(value) == 0 {
+		return move, false, diags
+	}
+	if err := json.Unmarshal(value, &move); err != nil {
+		diags.AddError("Invalid private state", fmt.Sprintf("Unable to decode the planned database move, got error: %s", err))
+		return move, false, diags
+	}
+	return move, true, diags
+}
+
+// clearDatabaseMove removes the databaseMoveKey mark from the private state,
+// if it is set.
+func clearDatabaseMove(ctx context.Context, private privateState) diag.Diagnostics {
+	value, diags := private.GetKey(ctx, databaseMoveKey)
+	if diags.HasError() || len(value) == 0 {
+		return diags
+	}
+	return private.SetKey(ctx, databaseMoveKey, nil)
+}
+
+// planDatabaseMove plans a change of group as a replacement which moves the
+// database, records the move in the private state, and describes it in a
+// warning. The move is removed from the private state by any other plan.
+func planDatabaseMove(ctx context.Context, state resource_database.DatabaseModel, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var group, name types.String
+	diags := resp.Plan.GetAttribute(ctx, path.Root("group"), &group)
+	diags.Append(resp.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	if diags.HasError() {
+		return diags
+	}
+	// A database with another name, or which is replaced for another reason,
+	// is a new database rather than a move.
+	if group.IsNull() || group.Equal(state.Group) || !name.Equal(state.Name) || len(resp.RequiresReplace) > 0 {
+		diags.Append(clearDatabaseMove(ctx, resp.Private)...)
+		return diags
+	}
+
+	// The group is only known when applying, so the database may be moved.
+	target := "a group which is known after apply"
+	if !group.IsUnknown() {
+		target = fmt.Sprintf("group %q", group.ValueString())
+	}
+	diags.Append(checkDatabaseMovable(state, target)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	move := databaseMove{}
+	if isProvided(state.Seed) {
+		move.Seed = map[string]*string{
+			"dump_file_hash": stringPointer(state.Seed.DumpFileHash),
+			"name":           stringPointer(state.Seed.Name),
+			"timestamp":      stringPointer(state.Seed.Timestamp),
+			"type":           stringPointer(state.Seed.SeedType),
+			"url":            stringPointer(state.Seed.Url),
+		}
+	}
+	if isProvided(state.SeededFrom) {
+		move.SeededFrom = map[string]*string{
+			"database":    stringPointer(state.SeededFrom.Database),
+			"database_id": stringPointer(state.SeededFrom.DatabaseId),
+			"timestamp":   stringPointer(state.SeededFrom.Timestamp),
+		}
+	}
+	value, err := json.Marshal(move)
+	if err != nil {
+		diags.AddError("Invalid private state", fmt.Sprintf("Unable to encode the planned database move, got error: %s", err))
+		return diags
+	}
+	diags.Append(resp.Private.SetKey(ctx, databaseMoveKey, value)...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("group"))
+
+	snapshot := ""
+	if isProvided(state.FinalSnapshot) {
+		snapshot = fmt.Sprintf("A final snapshot of the original is written to %s first. ", state.FinalSnapshot.ValueString())
+	}
+	diags.AddAttributeWarning(
+		path.Root("group"),
+		"Database will be moved",
+		fmt.Sprintf(
+			"Database %q will be replaced to move it from group %q to %s. "+
+				"%s"+
+				"The original database is kept until its contents are copied: writes to it are blocked while a dump of it seeds the temporary database %q in the new group. "+
+				"Once the row count of every table of the copy matches the original, the original is deleted and recreated in the new group from the copy, which is deleted afterwards. "+
+				"The database is unavailable until it is recreated, and tokens created for it before the move must be recreated.",
+			state.Name.ValueString(), state.Group.ValueString(), target,
+			snapshot,
+			moveStagingName(state.Name.ValueString()),
+		),
+	)
+	return diags
+}
+
+// checkDatabaseMovable returns an error if the database cannot be moved to
+// target. Moving deletes the original database, so it must not be protected
+// from deletion.
+func checkDatabaseMovable(state resource_database.DatabaseModel, target string) diag.Diagnostics {
+	if state.Database.IsSchema.ValueBool() || state.Database.Schema.ValueString() != "" {
+		return diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				path.Root("group"),
+				"Database cannot be moved",
+				fmt.Sprintf("Database %q uses or is a schema database, which must be in the same group as its children. Create a new database in %s instead.", state.Name.ValueString(), target),
+			),
+		}
+	}
+	return checkDeletionProtection(state.DeletionProtection, "database", state.Name.ValueString())
+}
+
+// planMovedDatabase plans the database which replaces a moved one. Its seed
+// attributes which are not configured and its seeded_from record are kept
+// from the original database.
+func planMovedDatabase(ctx context.Context, move databaseMove, plan *tfsdk.Plan) diag.Diagnostics {
+	var seed resource_database.SeedValue
+	diags := plan.GetAttribute(ctx, path.Root("seed"), &seed)
+	if diags.HasError() {
+		return diags
+	}
+	if isProvided(seed) {
+		for name, v := range map[string]*types.String{
+			"dump_file_hash": &seed.DumpFileHash,
+			"name":           &seed.Name,
+			"timestamp":      &seed.Timestamp,
+			"type":           &seed.SeedType,
+			"url":            &seed.Url,
+		} {
+			if v.IsUnknown() {
+				*v = stringFromPointer(move.Seed[name])
+			}
+		}
+		diags.Append(plan.SetAttribute(ctx, path.Root("seed"), seed)...)
+	}
+
+	seededFrom := resource_database.NewSeededFromValueNull()
+	if move.SeededFrom != nil {
+		var d diag.Diagnostics
+		seededFrom, d = resource_database.NewSeededFromValue(resource_database.SeededFromValue{}.AttributeTypes(ctx), map[string]attr.Value{
+			"database":    stringFromPointer(move.SeededFrom["database"]),
+			"database_id": stringFromPointer(move.SeededFrom["database_id"]),
+			"timestamp":   stringFromPointer(move.SeededFrom["timestamp"]),
+		})
+		diags.Append(d...)
+	}
+	diags.Append(plan.SetAttribute(ctx, path.Root("seeded_from"), seededFrom)...)
+	return diags
+}
+
+// createMovedDatabase creates the database planned in data by moving the
+// existing database of the same name to the planned group. A database can
+// only be seeded from another database in the same group, so the original is
+// exported as a dump which seeds a temporary copy in the new group. Writes to
+// the original are blocked while it is copied, so that none are lost. Once the
+// row count of every table of the copy matches the original, the original is
+// deleted and recreated in the new group from the copy, and verified in the
+// same way. Tokens used to read the databases expire after ttl.
+//
+// It reports whether data holds a database to save to state, which is the
+// moved database on success. If the move fails before the original is
+// deleted, data holds the original, left as it was, so that the next apply
+// moves it again. If the original was deleted but could not be recreated, data
+// holds the copy with deletion protection turned on, since it is the only
+// database with the contents.
+func (r *DatabaseResource) createMovedDatabase(ctx context.Context, data *resource_database.DatabaseModel, ttl time.Duration) (bool, diag.Diagnostics) {
+	name := data.Name.ValueString()
+	group := data.Group.ValueString()
+	original, diags := r.readDatabase(ctx, name)
+	if diags.HasError() {
+		diags.AddError("Database move failed", fmt.Sprintf("Database %q could not be read to move it to group %q, so it was not moved. If it still exists, import it before applying again.", name, group))
+		return false, diags
+	}
+	// The group was unknown when planning and turned out not to change.
+	if original.Group.Value == group {
+		diags.Append(r.setDatabaseResource(ctx, original, data)...)
+		return !diags.HasError(), diags
+	}
+	from := original.Group.Value
+	staging := moveStagingName(name)
+	tflog.Debug(ctx, "moving database", map[string]interface{}{
+		"database": name,
+		"from":     from,
+		"to":       group,
+	})
+
+	sizeLimit, err := optSize(data.SizeLimit)
+	if err != nil {
+		diags.AddAttributeError(path.Root("size_limit"), "Invalid size", err.Error())
+		return false, diags
+	}
+	// Settings which are not configured are carried over from the original.
+	if !isProvided(data.AllowAttach) {
+		data.AllowAttach = types.BoolValue(original.AllowAttach.Value)
+	}
+	if !isProvided(data.BlockReads) {
+		data.BlockReads = types.BoolValue(original.BlockReads.Value)
+	}
+	if !isProvided(data.BlockWrites) {
+		data.BlockWrites = types.BoolValue(original.BlockWrites.Value)
+	}
+
+	keepOriginal := func(diags diag.Diagnostics) (bool, diag.Diagnostics) {
+		diags.Append(r.setBlockWrites(ctx, name, original.BlockWrites.Value)...)
+		readDiags := r.readDatabaseResource(ctx, name, data)
+		if readDiags.HasError() {
+			diags.AddError(
+				"Database move failed",
+				fmt.Sprintf("Database %q was left in group %q, but could not be read back, got error: %s. Import it before applying again.", name, from, readDiags[0].Detail()),
+			)
+			return false, diags
+		}
+		return true, diags
+	}
+	deleteStaging := func(diags diag.Diagnostics) diag.Diagnostics {
+		if err := r.deleteDatabase(ctx, staging); err != nil {
+			diags.AddWarning("Temporary database not deleted", fmt.Sprintf("Unable to delete the temporary database %q, got error: %s. Delete it manually.", staging, err))
+		}
+		return diags
+	}
+
+	diags.Append(r.setBlockWrites(ctx, name, true)...)
+	if diags.HasError() {
+		return keepOriginal(diags)
+	}
+	counts, d := r.tableRowCounts(ctx, name, ttl, false)
+	diags.Append(d...)
+	if diags.HasError() {
+		return keepOriginal(diags)
+	}
+	dump, d := r.dumpDatabase(ctx, name, ttl)
+	diags.Append(d...)
+	if diags.HasError() {
+		return keepOriginal(diags)
+	}
+	dumpURL, err := r.uploadDatabaseDump(ctx, name+".sql", dump)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to upload the dump of database %q to move it to group %q, got error: %s", name, group, err))
+		return keepOriginal(diags)
+	}
+	diags.Append(r.copyDatabase(ctx, staging, group, tursoclient.CreateDatabaseInputSeed{
+		Type: tursoclient.NewOptCreateDatabaseInputSeedType(tursoclient.CreateDatabaseInputSeedType(seedTypeDump)),
+		URL:  tursoclient.NewOptString(dumpURL),
+	}, sizeLimit, counts, ttl)...)
+	if diags.HasError() {
+		return keepOriginal(diags)
+	}
+
+	if err := r.deleteDatabase(ctx, name); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to delete database %q to move it to group %q, got error: %s", name, group, err))
+		return keepOriginal(deleteStaging(diags))
+	}
+
+	diags.Append(r.copyDatabase(ctx, name, group, tursoclient.CreateDatabaseInputSeed{
+		Type: tursoclient.NewOptCreateDatabaseInputSeedType(tursoclient.CreateDatabaseInputSeedType(seedTypeDatabase)),
+		Name: tursoclient.NewOptString(staging),
+	}, sizeLimit, counts, ttl)...)
+	if diags.HasError() {
+		readDiags := r.readDatabaseResource(ctx, staging, data)
+		if readDiags.HasError() {
+			diags.AddError(
+				"Database move incomplete",
+				fmt.Sprintf("Database %q was deleted from group %q but could not be recreated in group %q. Its contents are kept in the database %q in group %q, which could not be read back, got error: %s. Import it before applying again.", name, from, group, staging, group, readDiags[0].Detail()),
+			)
+			return false, diags
+		}
+		data.DeletionProtection = types.BoolValue(true)
+		diags.AddError(
+			"Database move incomplete",
+			fmt.Sprintf("Database %q was deleted from group %q but could not be recreated in group %q. Its contents are kept in the database %q in group %q, which is saved to state in its place with deletion protection turned on, so that it is not destroyed by the next apply. Create %q from it with a seed of type database before deleting it.", name, from, group, staging, group, name),
+		)
+		return true, diags
+	}
+
+	if err := r.deleteDatabase(ctx, staging); err != nil {
+		diags.AddWarning("Temporary database not deleted", fmt.Sprintf("Database %q was moved, but the temporary database %q could not be deleted, got error: %s. Delete it manually.", name, staging, err))
+	}
+	diags.Append(r.readDatabaseResource(ctx, name, data)...)
+	if diags.HasError() {
+		return false, diags
+	}
+	diags.Append(r.updateDatabaseConfiguration(ctx, name, data)...)
+	return true, diags
+}
+
+// dumpDatabase exports the database as a SQL dump, using a read-only token
+// which expires after ttl.
+func (r *DatabaseResource) dumpDatabase(ctx context.Context, name string, ttl time.Duration) ([]byte, diag.Diagnostics) {
+	endpoint, diags := r.databaseEndpoint(ctx, name, "", tursoclient.CreateDatabaseTokenAuthorizationReadOnly, ttl)
+	if diags.HasError() {
+		return nil, diags
+	}
+	var dump bytes.Buffer
+	if err := endpoint.dump(ctx, &dump); err != nil {
+		return nil, diag.Diagnostics{
+			diag.NewErrorDiagnostic("Database dump failed", fmt.Sprintf("Unable to export database %q, got error: %s", name, err)),
+		}
+	}
+	return dump.Bytes(), nil
+}
+
+// tableRowCounts returns the number of rows in each table of the database,
+// using a read-only token which expires after ttl. A database which was just
+// created may not serve queries yet, so with wait set the rows are counted
+// again until ctx is done.
+func (r *DatabaseResource) tableRowCounts(ctx context.Context, name string, ttl time.Duration, wait bool) (map[string]int64, diag.Diagnostics) {
+	db, diags := r.openDatabase(ctx, name, "", tursoclient.CreateDatabaseTokenAuthorizationReadOnly, ttl)
+	if diags.HasError() {
+		return nil, diags
+	}
+	defer db.Close()
+
+	var counts map[string]int64
+	count := func(ctx context.Context) error {
+		var err error
+		counts, err = countTableRows(ctx, db)
+		return err
+	}
+	var err error
+	if wait {
+		err = r.poll(ctx, count)
+	} else {
+		err = count(ctx)
+	}
+	if err != nil {
+		return nil, diag.Diagnostics{
+			diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Unable to count the rows of database %q, got error: %s", name, err)),
+		}
+	}
+	return counts, nil
+}
+
+// countTableRows returns the number of rows in each table of db.
+func countTableRows(ctx context.Context, db *sql.DB) (map[string]int64, error) {
+	rows, err := db.QueryContext(ctx, listTablesSQL)
+	if err != nil {
+		return nil, fmt.Errorf("listing tables: %w", err)
+	}
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("listing tables: %w", err)
+		}
+		tables = append(tables, table)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing tables: %w", err)
+	}
+
+	counts := make(map[string]int64, len(tables))
+	for _, table := range tables {
+		var count int64
+		if err := db.QueryRowContext(ctx, "SELECT count(*) FROM "+quoteIdentifier(table)).Scan(&count); err != nil {
+			return nil, fmt.Errorf("counting rows of %s: %w", table, err)
+		}
+		counts[table] = count
+	}
+	return counts, nil
+}
+
+// describeRowCountDifferences describes how the row counts of a copy differ
+// from those of the original.
+func describeRowCountDifferences(got, want map[string]int64) string {
+	tables := slices.Sorted(maps.Keys(want))
+	for table := range got {
+		if _, ok := want[table]; !ok {
+			tables = append(tables, table)
+		}
+	}
+	var differences []string
+	for _, table := range tables {
+		n, ok := got[table]
+		switch {
+		case !ok:
+			differences = append(differences, fmt.Sprintf("table %s is missing", table))
+		case n != want[table]:
+			differences = append(differences, fmt.Sprintf("table %s has %d rows instead of %d", table, n, want[table]))
+		}
+	}
+	return strings.Join(differences, ", ")
+}
+
+// copyDatabase creates the database name in group from seed, and verifies
+// that the copy is in the group and that the row count of each of its tables
+// matches want. A copy which cannot be verified is deleted.
+func (r *DatabaseResource) copyDatabase(ctx context.Context, name, group string, seed tursoclient.CreateDatabaseInputSeed, sizeLimit tursoclient.OptString, want map[string]int64, ttl time.Duration) diag.Diagnostics {
+	tflog.Debug(ctx, "copying database", map[string]interface{}{
+		"database":  name,
+		"group":     group,
+		"seed_type": seed.Type.Value,
+		"seed_name": seed.Name.Value,
+	})
+	res, err := r.Client.CreateDatabase(ctx, &tursoclient.CreateDatabaseInput{
+		Name:      name,
+		Group:     group,
+		Seed:      tursoclient.NewOptCreateDatabaseInputSeed(seed),
+		SizeLimit: sizeLimit,
+	}, tursoclient.CreateDatabaseParams{
+		OrganizationName: r.Organization,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Unable to create the copy %q in group %q, got error: %s", name, group, err)),
+		}
+	}
+	if _, ok := res.(*tursoclient.CreateDatabaseOK); !ok {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Unable to create the copy %q in group %q, got response: %+v", name, group, res)),
+		}
+	}
+
+	db, diags := r.readDatabase(ctx, name)
+	if !diags.HasError() && db.Group.Value != group {
+		diags.AddError("Database copy failed", fmt.Sprintf("The copy %q was created in group %q instead of %q.", name, db.Group.Value, group))
+	}
+	if !diags.HasError() {
+		var got map[string]int64
+		got, diags = r.tableRowCounts(ctx, name, ttl, true)
+		if !diags.HasError() && !maps.Equal(got, want) {
+			diags.AddError("Database copy failed", fmt.Sprintf("The copy %q does not match the database being moved: %s.", name, describeRowCountDifferences(got, want)))
+		}
+	}
+	if diags.HasError() {
+		if err := r.deleteDatabase(ctx, name); err != nil {
+			diags.AddWarning("Temporary database not deleted", fmt.Sprintf("Unable to delete the database %q, got error: %s. Delete it manually.", name, err))
+		}
+	}
+	return diags
+}
+
+// setBlockWrites blocks or unblocks writes to the database.
+func (r *DatabaseResource) setBlockWrites(ctx context.Context, name string, block bool) diag.Diagnostics {
+	tflog.Debug(ctx, "updating database configuration", map[string]interface{}{
+		"database":     name,
+		"block_writes": block,
+	})
+	_, err := r.Client.UpdateDatabaseConfiguration(ctx, &tursoclient.DatabaseConfigurationInput{
+		BlockWrites: tursoclient.NewOptBool(block),
+	}, tursoclient.UpdateDatabaseConfigurationParams{
+		OrganizationName: r.Organization,
+		DatabaseName:     name,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Unable to update the configuration of database %q, got error: %s", name, err)),
+		}
+	}
+	return nil
+}
+
+// deleteDatabase deletes the database.
+func (r *DatabaseResource) deleteDatabase(ctx context.Context, name string) error {
+	tflog.Debug(ctx, "deleting database", map[string]interface{}{
+		"database": name,
+	})
+	res, err := r.Client.DeleteDatabase(ctx, tursoclient.DeleteDatabaseParams{
+		OrganizationName: r.Organization,
+		DatabaseName:     name,
+	})
+	if err != nil {
+		return err
+	}
+	if _, ok := res.(*tursoclient.DeleteDatabaseOK); !ok {
+		return fmt.Errorf("unexpected response: %+v", res)
+	}
+	return nil
+}
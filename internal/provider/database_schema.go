@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// getSchemaDatabase returns the parent schema database of a new database, or an
+// error diagnostic if it is not a schema database or is not in group. It
+// reports false if the schema database does not exist.
+func (r *tursoProviderConfig) getSchemaDatabase(ctx context.Context, name string, group types.String) (tursoclient.Database, bool, diag.Diagnostics) {
+	schemaPath := path.Root("schema")
+	res, err := r.Client.GetDatabase(ctx, tursoclient.GetDatabaseParams{
+		OrganizationName: r.Organization,
+		DatabaseName:     name,
+	})
+	if err != nil {
+		return tursoclient.Database{}, false, diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(schemaPath, "Client Error", fmt.Sprintf("Unable to read schema database, got error: %s", err)),
+		}
+	}
+	found, ok := res.(*tursoclient.GetDatabaseOK)
+	if !ok {
+		return tursoclient.Database{}, false, nil
+	}
+	db := found.Database.Value
+	if !db.IsSchema.Value {
+		return tursoclient.Database{}, true, diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				schemaPath,
+				"Invalid schema database",
+				fmt.Sprintf("The database %q is not a schema database. Set `is_schema = true` on it to use it as a schema.", name),
+			),
+		}
+	}
+	if isProvided(group) && db.Group.Value != group.ValueString() {
+		return tursoclient.Database{}, true, diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				schemaPath,
+				"Invalid schema database",
+				fmt.Sprintf("The schema database %q is in group %q, but child databases must be in the same group, %q.", name, db.Group.Value, group.ValueString()),
+			),
+		}
+	}
+	return db, true, nil
+}
+
+// checkSchemaDatabase returns an error diagnostic if the schema database of a
+// new database does not exist, is not a schema database, or is not in group.
+func (r *tursoProviderConfig) checkSchemaDatabase(ctx context.Context, name string, group types.String) diag.Diagnostics {
+	_, found, diags := r.getSchemaDatabase(ctx, name, group)
+	if !found && !diags.HasError() {
+		diags.AddAttributeError(path.Root("schema"), "Schema database not found", fmt.Sprintf("The schema database %q does not exist.", name))
+	}
+	return diags
+}
+
+// listSchemaChildren returns the sorted names of the databases using the
+// schema database name as their schema.
+func (r *tursoProviderConfig) listSchemaChildren(ctx context.Context, name string) ([]string, error) {
+	res, err := r.Client.ListDatabases(ctx, tursoclient.ListDatabasesParams{
+		OrganizationName: r.Organization,
+		Schema:           tursoclient.NewOptString(name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	children := make([]string, 0, len(res.Databases))
+	for _, db := range res.Databases {
+		// The parent is not its own child, should the API include it.
+		if db.Name.Value != name {
+			children = append(children, db.Name.Value)
+		}
+	}
+	slices.Sort(children)
+	return children, nil
+}
@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/resource_database"
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ht "github.com/ogen-go/ogen/http"
+)
+
+const (
+	// seedTypeDatabase is the seed type used for databases branched from
+	// another database.
+	seedTypeDatabase = "database"
+	// seedTypeDump is the seed type used for databases created from a dump.
+	seedTypeDump = "dump"
+)
+
+// isDatabaseSeed reports whether the seed branches from another database,
+// either explicitly or because only the source database name is set.
+func isDatabaseSeed(seed resource_database.SeedValue) bool {
+	if !isProvided(seed) {
+		return false
+	}
+	if isProvided(seed.SeedType) {
+		return seed.SeedType.ValueString() == seedTypeDatabase
+	}
+	source, _ := seedSource(seed)
+	return isProvided(source)
+}
+
+// seedSource returns the name of the database the seed branches from and the
+// path of the attribute it is set in. The source is set in database, or in
+// name by configurations written before database was added.
+func seedSource(seed resource_database.SeedValue) (types.String, path.Path) {
+	if !seed.Database.IsNull() {
+		return seed.Database, path.Root("seed").AtName("database")
+	}
+	return seed.Name, path.Root("seed").AtName("name")
+}
+
+// validateSeedTimestamp returns an error if timestamp is not an RFC 3339
+// timestamp in the past.
+func validateSeedTimestamp(timestamp string, now time.Time) error {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return fmt.Errorf("expected an RFC 3339 timestamp such as %s: %w", now.UTC().Format(time.RFC3339), err)
+	}
+	if t.After(now) {
+		return fmt.Errorf("%s is in the future", timestamp)
+	}
+	return nil
+}
+
+// getSeedDatabase returns the source database of a database seed, configured
+// at namePath, or an error diagnostic if it cannot be used by a database in
+// group. It reports false if the source database does not exist.
+func (r *tursoProviderConfig) getSeedDatabase(ctx context.Context, name string, namePath path.Path, group types.String) (tursoclient.Database, bool, diag.Diagnostics) {
+	res, err := r.Client.GetDatabase(ctx, tursoclient.GetDatabaseParams{
+		OrganizationName: r.Organization,
+		DatabaseName:     name,
+	})
+	if err != nil {
+		return tursoclient.Database{}, false, diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(namePath, "Client Error", fmt.Sprintf("Unable to read seed database, got error: %s", err)),
+		}
+	}
+	found, ok := res.(*tursoclient.GetDatabaseOK)
+	if !ok {
+		return tursoclient.Database{}, false, nil
+	}
+	db := found.Database.Value
+	if isProvided(group) && db.Group.Value != group.ValueString() {
+		return tursoclient.Database{}, true, diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				namePath,
+				"Invalid seed database",
+				fmt.Sprintf("The database %q to seed from is in group %q, but databases can only be seeded from a database in the same group, %q.", name, db.Group.Value, group.ValueString()),
+			),
+		}
+	}
+	return db, true, nil
+}
+
+// planSeededFrom returns the planned record of the database a new database is
+// seeded from. The source database may be created in the same apply, so while
+// it does not exist the record is unknown and the source is checked by
+// seededFrom when the database is created.
+func (r *tursoProviderConfig) planSeededFrom(ctx context.Context, group types.String, seed resource_database.SeedValue) (resource_database.SeededFromValue, diag.Diagnostics) {
+	seededFrom, found, diags := r.lookupSeededFrom(ctx, group, seed)
+	if !found {
+		return resource_database.NewSeededFromValueUnknown(), diags
+	}
+	return seededFrom, diags
+}
+
+// seededFrom returns the record of the database the new database is seeded
+// from, or null if it is not seeded from another database. The record is
+// unknown while the seed configuration is.
+func (r *tursoProviderConfig) seededFrom(ctx context.Context, group types.String, seed resource_database.SeedValue) (resource_database.SeededFromValue, diag.Diagnostics) {
+	seededFrom, found, diags := r.lookupSeededFrom(ctx, group, seed)
+	if !found && !diags.HasError() {
+		source, sourcePath := seedSource(seed)
+		diags.AddAttributeError(
+			sourcePath,
+			"Seed database not found",
+			fmt.Sprintf("The database %q to seed from does not exist.", source.ValueString()),
+		)
+	}
+	return seededFrom, diags
+}
+
+// lookupSeededFrom returns the record of the database the new database is
+// seeded from, as seededFrom does. It reports false if the source database
+// does not exist.
+func (r *tursoProviderConfig) lookupSeededFrom(ctx context.Context, group types.String, seed resource_database.SeedValue) (resource_database.SeededFromValue, bool, diag.Diagnostics) {
+	source, sourcePath := seedSource(seed)
+	switch {
+	case seed.IsUnknown(), isProvided(seed) && (seed.SeedType.IsUnknown() || seed.SeedType.IsNull() && source.IsUnknown()):
+		return resource_database.NewSeededFromValueUnknown(), true, nil
+	case !isDatabaseSeed(seed):
+		return resource_database.NewSeededFromValueNull(), true, nil
+	case source.IsUnknown():
+		return resource_database.NewSeededFromValueUnknown(), true, nil
+	}
+
+	db, found, diags := r.getSeedDatabase(ctx, source.ValueString(), sourcePath, group)
+	if !found || diags.HasError() {
+		return resource_database.NewSeededFromValueUnknown(), found, diags
+	}
+	seededFrom, diags := resource_database.NewSeededFromValue(resource_database.SeededFromValue{}.AttributeTypes(ctx), map[string]attr.Value{
+		"database":    types.StringValue(db.Name.Value),
+		"database_id": types.StringValue(db.DbId.Value),
+		"timestamp":   seed.Timestamp,
+	})
+	return seededFrom, true, diags
+}
+
+// readDumpFile reads the dump file at name and returns its contents along with
+// their SHA-256 hash.
+func readDumpFile(name string) ([]byte, string, error) {
+	contents, err := os.ReadFile(name)
+	if err != nil {
+		return nil, "", err
+	}
+	sum := sha256.Sum256(contents)
+	return contents, hex.EncodeToString(sum[:]), nil
+}
+
+// uploadDatabaseDump uploads the dump so it can be used to seed a new database,
+// and returns the URL to pass as the seed URL.
+func (r *tursoProviderConfig) uploadDatabaseDump(ctx context.Context, name string, contents []byte) (string, error) {
+	tflog.Debug(ctx, "uploading database dump", map[string]interface{}{
+		"file": name,
+		"size": len(contents),
+	})
+	res, err := r.Client.UploadDatabaseDump(ctx, &tursoclient.UploadDatabaseDumpReq{
+		File: ht.MultipartFile{
+			Name: filepath.Base(name),
+			File: bytes.NewReader(contents),
+			Size: int64(len(contents)),
+		},
+	}, tursoclient.UploadDatabaseDumpParams{
+		OrganizationName: r.Organization,
+	})
+	if err != nil {
+		return "", err
+	}
+	if !res.DumpURL.Set {
+		return "", fmt.Errorf("dump URL not returned from server")
+	}
+	return res.DumpURL.Value.String(), nil
+}
+
+// createDatabaseSeed returns the seed to create the database from. If a dump
+// file is configured, it is uploaded first and the seed is updated with the
+// resulting URL.
+func (r *tursoProviderConfig) createDatabaseSeed(ctx context.Context, seed *resource_database.SeedValue) (tursoclient.OptCreateDatabaseInputSeed, diag.Diagnostics) {
+	if !isProvided(*seed) {
+		return tursoclient.OptCreateDatabaseInputSeed{}, nil
+	}
+
+	if isProvided(seed.DumpFile) {
+		dumpFilePath := path.Root("seed").AtName("dump_file")
+		contents, hash, err := readDumpFile(seed.DumpFile.ValueString())
+		if err != nil {
+			return tursoclient.OptCreateDatabaseInputSeed{}, diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(dumpFilePath, "Unable to read dump file", err.Error()),
+			}
+		}
+		if isProvided(seed.DumpFileHash) && seed.DumpFileHash.ValueString() != hash {
+			return tursoclient.OptCreateDatabaseInputSeed{}, diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					dumpFilePath,
+					"Dump file changed",
+					fmt.Sprintf("The dump file %s changed after the plan was created. Run terraform plan again to seed the database from its current contents.", seed.DumpFile.ValueString()),
+				),
+			}
+		}
+		dumpURL, err := r.uploadDatabaseDump(ctx, seed.DumpFile.ValueString(), contents)
+		if err != nil {
+			return tursoclient.OptCreateDatabaseInputSeed{}, diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(dumpFilePath, "Client Error", fmt.Sprintf("Unable to upload database dump, got error: %s", err)),
+			}
+		}
+		seed.SeedType = types.StringValue(seedTypeDump)
+		seed.Url = types.StringValue(dumpURL)
+		seed.DumpFileHash = types.StringValue(hash)
+	}
+
+	if isDatabaseSeed(*seed) {
+		seed.SeedType = types.StringValue(seedTypeDatabase)
+	}
+
+	source, _ := seedSource(*seed)
+	createSeed := tursoclient.CreateDatabaseInputSeed{
+		Type:      tursoclient.NewOptCreateDatabaseInputSeedType(tursoclient.CreateDatabaseInputSeedType(seed.SeedType.ValueString())),
+		Name:      optString(source),
+		URL:       optString(seed.Url),
+		Timestamp: optString(seed.Timestamp),
+	}
+
+	// Attributes which were neither configured nor set above are not reported
+	// by the API, so they are stored as null.
+	for _, v := range []*types.String{&seed.DumpFileHash, &seed.Name, &seed.Timestamp, &seed.SeedType, &seed.Url} {
+		if v.IsUnknown() {
+			*v = types.StringNull()
+		}
+	}
+
+	return tursoclient.NewOptCreateDatabaseInputSeed(createSeed), nil
+}
+
+// dumpFileHashModifier plans the hash of the configured dump file and requires
+// replacement when it differs from the hash of the dump the database was
+// seeded from.
+type dumpFileHashModifier struct{}
+
+var _ planmodifier.String = dumpFileHashModifier{}
+
+// Description implements planmodifier.String.
+func (m dumpFileHashModifier) Description(context.Context) string {
+	return "Plans the hash of the dump file and requires replacement when its contents change."
+}
+
+// MarkdownDescription implements planmodifier.String.
+func (m dumpFileHashModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString implements planmodifier.String.
+func (m dumpFileHashModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var dumpFile types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, req.Path.ParentPath().AtName("dump_file"), &dumpFile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case dumpFile.IsUnknown():
+		resp.PlanValue = types.StringUnknown()
+		return
+	case dumpFile.IsNull():
+		resp.PlanValue = types.StringNull()
+		return
+	}
+
+	_, hash, err := readDumpFile(dumpFile.ValueString())
+	if err != nil {
+		// The dump is only needed to seed a new database. An existing
+		// database keeps the hash it was seeded with, so the dump file can
+		// be removed once it was applied.
+		if isProvided(req.StateValue) {
+			resp.PlanValue = req.StateValue
+			resp.Diagnostics.AddAttributeWarning(
+				req.Path.ParentPath().AtName("dump_file"),
+				"Unable to read dump file",
+				fmt.Sprintf("The database was seeded from the dump with hash %s, which is kept since the dump file cannot be read: %s. Changes to the dump are not detected until it can be read again.", req.StateValue.ValueString(), err),
+			)
+			return
+		}
+		// A moved database is copied rather than seeded from the dump, so
+		// the hash is planned from the original, see planMovedDatabase.
+		_, moving, diags := readDatabaseMove(ctx, req.Private)
+		resp.Diagnostics.Append(diags...)
+		if moving {
+			resp.PlanValue = types.StringUnknown()
+			return
+		}
+		resp.Diagnostics.AddAttributeError(req.Path.ParentPath().AtName("dump_file"), "Unable to read dump file", err.Error())
+		return
+	}
+	resp.PlanValue = types.StringValue(hash)
+
+	if isProvided(req.StateValue) && req.StateValue.ValueString() != hash {
+		resp.RequiresReplace = true
+	}
+}
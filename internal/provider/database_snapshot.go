@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// writeFinalSnapshot exports the database as a SQL dump to the file at
+// snapshotPath, using a read-only token which expires after ttl. The dump is
+// written to a temporary file first, so that an existing snapshot is only
+// replaced by a complete one.
+func (r *tursoProviderConfig) writeFinalSnapshot(ctx context.Context, name, snapshotPath string, ttl time.Duration) diag.Diagnostics {
+	endpoint, diags := r.databaseEndpoint(ctx, name, "", tursoclient.CreateDatabaseTokenAuthorizationReadOnly, ttl)
+	if diags.HasError() {
+		return diags
+	}
+
+	snapshotFailed := func(err error) diag.Diagnostics {
+		return diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				path.Root("final_snapshot"),
+				"Final snapshot failed",
+				fmt.Sprintf("Unable to write the final snapshot of database %q to %s, so it was not deleted, got error: %s", name, snapshotPath, err),
+			),
+		}
+	}
+	dir := filepath.Dir(snapshotPath)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return snapshotFailed(err)
+	}
+	f, err := os.CreateTemp(dir, "."+filepath.Base(snapshotPath)+".*")
+	if err != nil {
+		return snapshotFailed(err)
+	}
+	defer os.Remove(f.Name())
+
+	tflog.Debug(ctx, "writing final snapshot", map[string]interface{}{
+		"database": name,
+		"path":     snapshotPath,
+	})
+	if err := endpoint.dump(ctx, f); err != nil {
+		f.Close()
+		return snapshotFailed(err)
+	}
+	if err := f.Close(); err != nil {
+		return snapshotFailed(err)
+	}
+	if err := os.Rename(f.Name(), snapshotPath); err != nil {
+		return snapshotFailed(err)
+	}
+	return nil
+}
@@ -76,6 +76,8 @@ func (r *DatabaseDataSource) readDatabaseDataSource(ctx context.Context, name st
 		"name":           types.StringValue(db.Name.Value),
 		"group":          types.StringValue(db.Group.Value),
 		"hostname":       types.StringValue(db.Hostname.Value),
+		"http_url":       types.StringValue(httpURL(db.Hostname.Value)),
+		"libsql_url":     types.StringValue(libsqlURL(db.Hostname.Value)),
 		"regions":        encodeStringList(db.Regions),
 		"primary_region": types.StringValue(db.PrimaryRegion.Value),
 		"schema":         types.StringValue(db.Schema.Value),
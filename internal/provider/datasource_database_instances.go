@@ -66,11 +66,13 @@ func (r *DatabaseInstancesDataSource) Read(ctx context.Context, req datasource.R
 	instances := make([]attr.Value, len(res.Instances))
 	for i, instance := range res.Instances {
 		instanceVal, diags := datasource_database_instances.NewInstancesValue(datasource_database_instances.InstancesValue{}.AttributeTypes(ctx), map[string]attr.Value{
-			"hostname": basetypes.NewStringValue(instance.Hostname.Value),
-			"name":     basetypes.NewStringValue(instance.Name.Value),
-			"region":   basetypes.NewStringValue(instance.Region.Value),
-			"type":     basetypes.NewStringValue(string(instance.Type.Value)),
-			"uuid":     basetypes.NewStringValue(instance.UUID.Value),
+			"hostname":   basetypes.NewStringValue(instance.Hostname.Value),
+			"http_url":   basetypes.NewStringValue(httpURL(instance.Hostname.Value)),
+			"libsql_url": basetypes.NewStringValue(libsqlURL(instance.Hostname.Value)),
+			"name":       basetypes.NewStringValue(instance.Name.Value),
+			"region":     basetypes.NewStringValue(instance.Region.Value),
+			"type":       basetypes.NewStringValue(string(instance.Type.Value)),
+			"uuid":       basetypes.NewStringValue(instance.UUID.Value),
 		})
 		resp.Diagnostics.Append(diags...)
 		instances[i] = instanceVal
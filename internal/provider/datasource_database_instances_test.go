@@ -1,9 +1,18 @@
 package provider
 
 import (
+	"context"
+	"regexp"
 	"testing"
 
+	"github.com/celest-dev/terraform-provider-turso/internal/datasource_database_instances"
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
@@ -26,8 +35,53 @@ func TestAccDataSourceDatabaseInstances(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue("data.turso_database_instances.test", tfjsonpath.New("instances"), listNotEmpty{}),
 					statecheck.ExpectKnownValue("data.turso_database_instances.test", tfjsonpath.New("instances"), listOfNonNulls{}),
+					statecheck.ExpectKnownValue("data.turso_database_instances.test", tfjsonpath.New("instances").AtSliceIndex(0).AtMapKey("libsql_url"), knownvalue.StringRegexp(regexp.MustCompile(`^libsql://`))),
 				},
 			},
 		},
 	})
 }
+
+func TestDatabaseInstancesDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	config := fake.start(t)
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: config}, "test", "sjc", "sjc")
+	fake.addDatabase("app", "test", "app-test-org.turso.io")
+	fake.addInstance("app", "sjc")
+	d := &DatabaseInstancesDataSource{tursoProviderConfig: config}
+
+	var schemaResp fwdatasource.SchemaResponse
+	d.Schema(ctx, fwdatasource.SchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+	data := datasource_database_instances.DatabaseInstancesModel{
+		Id:        types.StringValue("app"),
+		Instances: types.ListNull(datasource_database_instances.InstancesValue{}.Type(ctx)),
+	}
+	state := tfsdk.State{Schema: s}
+	if diags := state.Set(ctx, data); diags.HasError() {
+		t.Fatalf("error encoding config: %v", diags)
+	}
+	resp := fwdatasource.ReadResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	d.Read(ctx, fwdatasource.ReadRequest{Config: tfsdk.Config{Schema: s, Raw: state.Raw}}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("error reading instances: %v", resp.Diagnostics)
+	}
+
+	if diags := resp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("error decoding state: %v", diags)
+	}
+	var instances []datasource_database_instances.InstancesValue
+	if diags := data.Instances.ElementsAs(ctx, &instances, false); diags.HasError() {
+		t.Fatalf("error decoding instances: %v", diags)
+	}
+	if len(instances) != 1 {
+		t.Fatalf("expected one instance, got %d", len(instances))
+	}
+	if got := instances[0].LibsqlUrl.ValueString(); got != "libsql://sjc.app-test-org.turso.io" {
+		t.Errorf("unexpected libsql_url %q", got)
+	}
+	if got := instances[0].HttpUrl.ValueString(); got != "https://sjc.app-test-org.turso.io" {
+		t.Errorf("unexpected http_url %q", got)
+	}
+}
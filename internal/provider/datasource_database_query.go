@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/datasource_database_query"
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSourceWithConfigure = &DatabaseQueryDataSource{}
+
+func NewDatabaseQueryDataSource() datasource.DataSource {
+	return &DatabaseQueryDataSource{}
+}
+
+// DatabaseQueryDataSource runs a SELECT statement against a database over its
+// libSQL HTTP API.
+type DatabaseQueryDataSource struct {
+	*tursoProviderConfig
+}
+
+func (r *DatabaseQueryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_query"
+}
+
+func (r *DatabaseQueryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_database_query.DatabaseQueryDataSourceSchema(ctx)
+	resp.Schema.Description = "Runs a read-only SQL statement against a database and returns the rows."
+	resp.Schema.MarkdownDescription = resp.Schema.Description
+
+	sqlAttr, ok := resp.Schema.Attributes["sql"].(schema.StringAttribute)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to configure sql attribute", "Failed to configure sql attribute")
+		return
+	}
+	sqlAttr.Validators = append(sqlAttr.Validators, selectStatementValidator{})
+	resp.Schema.Attributes["sql"] = sqlAttr
+}
+
+func (r *DatabaseQueryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tursoProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *tursoProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.tursoProviderConfig = client
+}
+
+func (r *DatabaseQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_database_query.DatabaseQueryModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
+	db, diags := r.openDatabase(ctx, data.Id.ValueString(), data.Instance.ValueString(), tursoclient.CreateDatabaseTokenAuthorizationReadOnly, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer db.Close()
+
+	var args []any
+	for _, arg := range decodeStringList(data.Args) {
+		args = append(args, arg)
+	}
+	res, err := db.QueryContext(ctx, data.Sql.ValueString(), args...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run query, got error: %s", err))
+		return
+	}
+	defer res.Close()
+
+	columns, err := res.Columns()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read query columns, got error: %s", err))
+		return
+	}
+	seen := make(map[string]bool, len(columns))
+	for i := range columns {
+		if columns[i] == "" {
+			columns[i] = fmt.Sprintf("column%d", i+1)
+		}
+		if seen[columns[i]] {
+			resp.Diagnostics.AddAttributeError(path.Root("sql"), "Duplicate column", fmt.Sprintf("The query returns more than one column named %q. Use AS to give each column a unique name.", columns[i]))
+			return
+		}
+		seen[columns[i]] = true
+	}
+
+	rowType := types.MapType{ElemType: types.StringType}
+	var rows []attr.Value
+	row := make([]any, len(columns))
+	dest := make([]any, len(columns))
+	for i := range row {
+		dest[i] = &row[i]
+	}
+	for res.Next() {
+		if err := res.Scan(dest...); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read query rows, got error: %s", err))
+			return
+		}
+		values := make(map[string]attr.Value, len(columns))
+		for i, value := range row {
+			values[columns[i]] = formatQueryValue(value)
+		}
+		value, diags := types.MapValue(types.StringType, values)
+		resp.Diagnostics.Append(diags...)
+		rows = append(rows, value)
+	}
+	if err := res.Err(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read query rows, got error: %s", err))
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Columns = encodeStringList(columns)
+	data.Rows, diags = types.ListValue(rowType, rows)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// formatQueryValue returns a value read from a query as a string. Blobs are
+// base64 encoded, and NULL is returned as null.
+func formatQueryValue(value any) types.String {
+	switch v := value.(type) {
+	case nil:
+		return types.StringNull()
+	case []byte:
+		return types.StringValue(base64.StdEncoding.EncodeToString(v))
+	case time.Time:
+		return types.StringValue(v.Format(time.RFC3339Nano))
+	default:
+		return types.StringValue(fmt.Sprint(v))
+	}
+}
+
+// selectStatementValidator validates that a string is a single SELECT
+// statement, which may start with a WITH clause.
+type selectStatementValidator struct{}
+
+func (v selectStatementValidator) Description(ctx context.Context) string {
+	return "value must be a single SELECT statement, which may start with a WITH clause"
+}
+
+func (v selectStatementValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a single `SELECT` statement, which may start with a `WITH` clause"
+}
+
+func (v selectStatementValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := checkSelectStatement(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid SQL statement", err.Error())
+	}
+}
+
+// checkSelectStatement returns an error unless sql is a single SELECT
+// statement, optionally followed by a semicolon. Comments, string literals
+// and quoted identifiers are skipped when looking for the end of the statement.
+//
+// A statement starting with a WITH clause is accepted without checking that
+// the statement it introduces is a SELECT; writes are rejected by the
+// read-only token the statement is run with.
+func checkSelectStatement(sql string) error {
+	rest := skipSQLSpace(sql)
+	keyword := strings.ToUpper(rest[:len(rest)-len(strings.TrimLeftFunc(rest, unicode.IsLetter))])
+	if keyword != "SELECT" && keyword != "WITH" {
+		if keyword == "" {
+			return fmt.Errorf("only SELECT statements are allowed")
+		}
+		return fmt.Errorf("only SELECT statements are allowed, got %s", keyword)
+	}
+
+	for i := 0; i < len(rest); i++ {
+		switch c := rest[i]; c {
+		case '\'', '"', '`', '[':
+			end := c
+			if c == '[' {
+				end = ']'
+			}
+			j := strings.IndexByte(rest[i+1:], end)
+			if j < 0 {
+				return fmt.Errorf("unterminated %c", c)
+			}
+			i += j + 1
+		case '-', '/':
+			if skipped := skipSQLSpace(rest[i:]); len(skipped) < len(rest[i:]) {
+				i = len(rest) - len(skipped) - 1
+			}
+		case ';':
+			if skipSQLSpace(rest[i+1:]) != "" {
+				return fmt.Errorf("only a single statement is allowed")
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+// skipSQLSpace returns sql without leading whitespace and comments.
+func skipSQLSpace(sql string) string {
+	for {
+		trimmed := strings.TrimLeftFunc(sql, unicode.IsSpace)
+		switch {
+		case strings.HasPrefix(trimmed, "--"):
+			_, after, found := strings.Cut(trimmed, "\n")
+			if !found {
+				return ""
+			}
+			sql = after
+		case strings.HasPrefix(trimmed, "/*"):
+			_, after, found := strings.Cut(trimmed[2:], "*/")
+			if !found {
+				return ""
+			}
+			sql = after
+		default:
+			return trimmed
+		}
+	}
+}
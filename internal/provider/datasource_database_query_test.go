@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/datasource_database_query"
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccDataSourceDatabaseQuery(t *testing.T) {
+	name := randomName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_database" "test" {
+					group = "test"
+					name = "` + name + `"
+				}
+				data "turso_database_query" "test" {
+					id = turso_database.test.id
+					sql = "SELECT ? AS flag, NULL AS missing"
+					args = ["on"]
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.turso_database_query.test", tfjsonpath.New("columns"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("flag"),
+						knownvalue.StringExact("missing"),
+					})),
+					statecheck.ExpectKnownValue("data.turso_database_query.test", tfjsonpath.New("rows"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"flag":    knownvalue.StringExact("on"),
+							"missing": knownvalue.Null(),
+						}),
+					})),
+				},
+			},
+			{
+				Config: testAccCreateConfig(`
+				data "turso_database_query" "test" {
+					id = "` + name + `"
+					sql = "DELETE FROM flags"
+				}`),
+				ExpectError: regexp.MustCompile(`only SELECT statements are allowed, got DELETE`),
+			},
+		},
+	})
+}
+
+func TestCheckSelectStatement(t *testing.T) {
+	tests := []struct {
+		sql string
+		err string
+	}{
+		{sql: "SELECT 1"},
+		{sql: "  select * from flags;  "},
+		{sql: "-- flags\n/* enabled */ SELECT name FROM flags WHERE enabled;\n-- done"},
+		{sql: "SELECT ';' AS a, \"b;\", [c;], `d;` FROM t -- ;\n"},
+		{sql: "SELECT 1 - 2 / 3"},
+		{sql: "", err: "only SELECT statements are allowed"},
+		{sql: "DELETE FROM flags", err: "only SELECT statements are allowed, got DELETE"},
+		{sql: "WITH x AS (SELECT 1) SELECT * FROM x"},
+		{sql: "with recursive n(i) AS (SELECT 1 UNION ALL SELECT i + 1 FROM n WHERE i < 3) SELECT i FROM n;"},
+		{sql: "SELECT 1; DROP TABLE flags", err: "only a single statement is allowed"},
+		{sql: "SELECT 1 /* ; */; -- ;", err: ""},
+		{sql: "SELECT 'unterminated", err: "unterminated '"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			err := checkSelectStatement(tt.sql)
+			if tt.err == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.err {
+				t.Errorf("expected error %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func readDatabaseQuery(t *testing.T, ctx context.Context, d *DatabaseQueryDataSource, data datasource_database_query.DatabaseQueryModel) fwdatasource.ReadResponse {
+	t.Helper()
+
+	var schemaResp fwdatasource.SchemaResponse
+	d.Schema(ctx, fwdatasource.SchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+	state := tfsdk.State{Schema: s}
+	if diags := state.Set(ctx, data); diags.HasError() {
+		t.Fatalf("error encoding config: %v", diags)
+	}
+	resp := fwdatasource.ReadResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	d.Read(ctx, fwdatasource.ReadRequest{Config: tfsdk.Config{Schema: s, Raw: state.Raw}}, &resp)
+	return resp
+}
+
+func TestDatabaseQueryDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	config := fake.start(t)
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: config}, "test", "sjc", "sjc")
+	fake.addDatabase("control", "test", "control-test-org.turso.io")
+	fake.addInstance("control", "sjc")
+	db := newFakeLibsql()
+	db.start(t, config, "control")
+	var hostnames []string
+	databaseURL := config.DatabaseURL
+	config.DatabaseURL = func(hostname string) string {
+		hostnames = append(hostnames, hostname)
+		return databaseURL(hostname)
+	}
+	d := &DatabaseQueryDataSource{tursoProviderConfig: config}
+
+	const sql = "SELECT name, region, size FROM tenants WHERE plan = ?"
+	db.results[sql] = &fakeResult{
+		Cols: []fakeCol{{Name: "name"}, {Name: "region"}, {Name: "size"}},
+		Rows: [][]fakeValue{
+			{textValue("acme"), textValue("sjc"), integerValue(42)},
+			{textValue("globex"), nullValue(), floatValue(1.5)},
+		},
+	}
+	data := datasource_database_query.DatabaseQueryModel{
+		Args:     encodeStringList([]string{"pro"}),
+		Columns:  types.ListNull(types.StringType),
+		Id:       types.StringValue("control"),
+		Instance: types.StringValue("sjc"),
+		Rows:     types.ListNull(types.MapType{ElemType: types.StringType}),
+		Sql:      types.StringValue(sql),
+	}
+	resp := readDatabaseQuery(t, ctx, d, data)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("error reading query: %v", resp.Diagnostics)
+	}
+
+	if !slices.Equal(hostnames, []string{"sjc.control-test-org.turso.io"}) {
+		t.Errorf("expected query to be sent to the instance hostname, got %v", hostnames)
+	}
+	if len(db.queries) != 1 || db.queries[0].Args[0].text() != "pro" {
+		t.Errorf("expected query with args [pro], got %+v", db.queries)
+	}
+	if calls := fake.callsTo("CreateDatabaseToken"); !slices.Equal(calls, []string{"CreateDatabaseToken control read-only 10m"}) {
+		t.Errorf("expected a read-only token, got %v", calls)
+	}
+
+	if diags := resp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("error decoding state: %v", diags)
+	}
+	if got := decodeStringList(data.Columns); !slices.Equal(got, []string{"name", "region", "size"}) {
+		t.Errorf("unexpected columns: %v", got)
+	}
+	var rows []map[string]*string
+	if diags := data.Rows.ElementsAs(ctx, &rows, false); diags.HasError() {
+		t.Fatalf("error decoding rows: %v", diags)
+	}
+	if len(rows) != 2 || *rows[0]["size"] != "42" || rows[1]["region"] != nil || *rows[1]["size"] != "1.5" {
+		t.Errorf("unexpected rows: %v", rows)
+	}
+
+	// Unknown instances are reported with the available ones.
+	data.Instance = types.StringValue("ams")
+	resp = readDatabaseQuery(t, ctx, d, data)
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Detail() != `Database control has no instance "ams". Its instances are: sjc.` {
+		t.Errorf("expected instance not found error, got %v", resp.Diagnostics)
+	}
+}
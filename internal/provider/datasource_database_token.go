@@ -27,14 +27,15 @@ func (r *DatabaseTokenDataSource) Metadata(ctx context.Context, req datasource.M
 
 func (r *DatabaseTokenDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = datasource_database_token.DatabaseTokenDataSourceSchema(ctx)
-	jwtRawAttr := resp.Schema.Attributes["jwt"]
-	jwtAttr, ok := jwtRawAttr.(schema.StringAttribute)
-	if !ok {
-		resp.Diagnostics.AddError("Failed to set jwt attribute as sensitive", "Failed to set jwt attribute as sensitive")
-		return
+	for _, name := range []string{"jwt", "connection_string"} {
+		attr, ok := resp.Schema.Attributes[name].(schema.StringAttribute)
+		if !ok {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to set %s attribute as sensitive", name), fmt.Sprintf("Failed to set %s attribute as sensitive", name))
+			return
+		}
+		attr.Sensitive = true
+		resp.Schema.Attributes[name] = attr
 	}
-	jwtAttr.Sensitive = true
-	resp.Schema.Attributes["jwt"] = jwtAttr
 }
 
 func (r *DatabaseTokenDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -83,7 +84,13 @@ func (r *DatabaseTokenDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 	switch token := token.(type) {
 	case *tursoclient.CreateDatabaseTokenOK:
+		db, diags := r.readDatabase(ctx, data.Id.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 		data.Jwt = basetypes.NewStringValue(token.Jwt.Value)
+		data.ConnectionString = basetypes.NewStringValue(connectionString(db.Hostname.Value, token.Jwt.Value))
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	default:
 		resp.Diagnostics.AddError("Failed to create database token", "Failed to create database token")
@@ -32,8 +32,9 @@ func TestAccResourceDatabaseToken_E2E(t *testing.T) {
 					statecheck.ExpectSensitiveValue("data.turso_database_token.test", tfjsonpath.New("jwt")),
 					statecheck.ExpectKnownValue("data.turso_database_token.test", tfjsonpath.New("jwt"), knownvalue.NotNull()),
 					statecheck.ExpectKnownValue("data.turso_database_token.test", tfjsonpath.New("expiration"), knownvalue.Null()), // no expiration
+					statecheck.ExpectSensitiveValue("data.turso_database_token.test", tfjsonpath.New("connection_string")),
 				},
-				Check: func(s *terraform.State) error {
+				Check: resource.ComposeTestCheckFunc(testAccCheckConnectionString("data.turso_database_token.test"), func(s *terraform.State) error {
 					dbName, ok := s.RootModule().Resources["turso_database.test"].Primary.Attributes["name"]
 					if !ok {
 						return fmt.Errorf("missing database")
@@ -60,8 +61,29 @@ func TestAccResourceDatabaseToken_E2E(t *testing.T) {
 					}
 
 					return nil
-				},
+				}),
 			},
 		},
 	})
 }
+
+// testAccCheckConnectionString checks that the connection_string of the token
+// data source connects to its database.
+func testAccCheckConnectionString(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		connectionString, ok := s.RootModule().Resources[name].Primary.Attributes["connection_string"]
+		if !ok {
+			return fmt.Errorf("missing connection string")
+		}
+		db, err := sql.Open("libsql", connectionString)
+		if err != nil {
+			return fmt.Errorf("error opening database: %v", err)
+		}
+		defer db.Close()
+
+		if _, err := db.Query("select 1"); err != nil {
+			return fmt.Errorf("error querying database: %v", err)
+		}
+		return nil
+	}
+}
@@ -69,12 +69,13 @@ func (r *GroupDataSource) readGroupDataSource(ctx context.Context, name string,
 
 	locations := encodeStringList(mergeLists(group.Locations, []string{group.Primary.Value}))
 	data.Group, diags = datasource_group.NewGroupValue(datasource_group.GroupValue{}.AttributeTypes(ctx), map[string]attr.Value{
-		"archived":  types.BoolValue(group.Archived.Value),
-		"name":      types.StringValue(group.Name.Value),
-		"primary":   types.StringValue(group.Primary.Value),
-		"uuid":      types.StringValue(group.UUID.Value),
-		"version":   types.StringValue(group.Version.Value),
-		"locations": locations,
+		"archived":   types.BoolValue(group.Archived.Value),
+		"extensions": groupExtensions(group.Extensions),
+		"name":       types.StringValue(group.Name.Value),
+		"primary":    types.StringValue(group.Primary.Value),
+		"uuid":       types.StringValue(group.UUID.Value),
+		"version":    types.StringValue(group.Version.Value),
+		"locations":  locations,
 	})
 
 	return diags
@@ -28,6 +28,7 @@ func TestAccDataSourceGroup(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue("data.turso_group.test", tfjsonpath.New("id"), knownvalue.StringExact(name)),
 					statecheck.ExpectKnownValue("data.turso_group.test", tfjsonpath.New("group"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("data.turso_group.test", tfjsonpath.New("group").AtMapKey("extensions"), knownvalue.Null()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.turso_group.test", "id", name),
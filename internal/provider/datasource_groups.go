@@ -76,12 +76,13 @@ func (r *GroupsDataSource) readGroupsDataSource(ctx context.Context, data *datas
 	for i, group := range res.Groups {
 		locations := encodeStringList(mergeLists(group.Locations, []string{group.Primary.Value}))
 		groups[i], diags = datasource_groups.NewGroupsValue(datasource_groups.GroupsValue{}.AttributeTypes(ctx), map[string]attr.Value{
-			"archived":  types.BoolValue(group.Archived.Value),
-			"name":      types.StringValue(group.Name.Value),
-			"primary":   types.StringValue(group.Primary.Value),
-			"uuid":      types.StringValue(group.UUID.Value),
-			"version":   types.StringValue(group.Version.Value),
-			"locations": locations,
+			"archived":   types.BoolValue(group.Archived.Value),
+			"extensions": groupExtensions(group.Extensions),
+			"name":       types.StringValue(group.Name.Value),
+			"primary":    types.StringValue(group.Primary.Value),
+			"uuid":       types.StringValue(group.UUID.Value),
+			"version":    types.StringValue(group.Version.Value),
+			"locations":  locations,
 		})
 		if diags.HasError() {
 			return diags
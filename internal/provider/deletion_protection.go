@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// planDeletionProtection plans the provider default for the deletion
+// protection of a resource being created which does not configure it. An
+// existing resource which does not configure it keeps the deletion protection
+// it has, so that changing the provider default does not change it.
+func (r *tursoProviderConfig) planDeletionProtection(ctx context.Context, config tfsdk.Config, state tfsdk.State, plan *tfsdk.Plan) diag.Diagnostics {
+	var configured types.Bool
+	diags := config.GetAttribute(ctx, path.Root("deletion_protection"), &configured)
+	if diags.HasError() || !configured.IsNull() {
+		return diags
+	}
+	planned := r.deletionProtection()
+	if !state.Raw.IsNull() {
+		diags.Append(state.GetAttribute(ctx, path.Root("deletion_protection"), &planned)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	diags.Append(plan.SetAttribute(ctx, path.Root("deletion_protection"), planned)...)
+	return diags
+}
+
+// deletionProtection returns the default deletion protection, which is on
+// until the provider is configured otherwise.
+func (r *tursoProviderConfig) deletionProtection() types.Bool {
+	if r == nil {
+		return types.BoolValue(true)
+	}
+	return types.BoolValue(r.DeletionProtection)
+}
+
+// checkDeletionProtection returns an error if the deletion protection saved in
+// the state of a resource is turned on. Protection only applies once it has
+// been saved by an apply, so resources from before deletion protection existed
+// can still be deleted.
+func checkDeletionProtection(deletionProtection types.Bool, kind, name string) diag.Diagnostics {
+	if !deletionProtection.ValueBool() {
+		return nil
+	}
+	return diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("deletion_protection"),
+			"Deletion protection enabled",
+			fmt.Sprintf("The %s %q is protected from deletion. Set `deletion_protection = false` and apply the change before destroying or replacing it.", kind, name),
+		),
+	}
+}
+
+// checkGroupEmpty returns an error if the group still has databases. Databases
+// managed in the same configuration as their group are destroyed first, so
+// this only blocks databases which are managed elsewhere or not at all.
+func (r *tursoProviderConfig) checkGroupEmpty(ctx context.Context, name string) diag.Diagnostics {
+	res, err := r.Client.ListDatabases(ctx, tursoclient.ListDatabasesParams{
+		OrganizationName: r.Organization,
+		Group:            tursoclient.NewOptString(name),
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Unable to list group databases, got error: %s", err)),
+		}
+	}
+	if len(res.Databases) == 0 {
+		return nil
+	}
+	names := make([]string, len(res.Databases))
+	for i, db := range res.Databases {
+		names[i] = db.Name.Value
+	}
+	slices.Sort(names)
+	return diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Group has databases",
+			fmt.Sprintf("Group %q still has the databases %s, which are not being destroyed with it. Delete them before deleting the group.", name, strings.Join(names, ", ")),
+		),
+	}
+}
@@ -0,0 +1,388 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeLibsql is an in-memory stand-in for a libSQL database, served over the
+// Hrana HTTP protocol. It understands the statements used to track migrations;
+// the statements of migration scripts are recorded rather than executed.
+type fakeLibsql struct {
+	mu sync.Mutex
+
+	// tables holds the names of the tables which were created.
+	tables map[string]bool
+	// tracked holds the rows of the tracking table as id and checksum.
+	tracked [][2]string
+	// statements holds the committed statements of migration scripts in the
+	// order they were run.
+	statements []string
+
+	// results holds the result returned for queries by SQL.
+	results map[string]*fakeResult
+	// queries records the queries executed outside of a transaction, in
+	// order.
+	queries []fakeStmt
+
+	// failOn makes the statement of a migration script fail instead of being
+	// run.
+	failOn map[string]bool
+
+	// dump is the SQL dump served at the dump endpoint.
+	dump string
+	// failDump makes the dump endpoint fail.
+	failDump bool
+
+	// streams holds the connection of each open stream by baton.
+	streams map[string]*fakeLibsqlConn
+	batons  int
+}
+
+func newFakeLibsql() *fakeLibsql {
+	return &fakeLibsql{
+		tables:  make(map[string]bool),
+		results: make(map[string]*fakeResult),
+		failOn:  make(map[string]bool),
+		streams: make(map[string]*fakeLibsqlConn),
+	}
+}
+
+// start serves the fake database for every hostname of the provider config,
+// accepting only the token minted by the fake Turso API for database.
+func (f *fakeLibsql) start(t *testing.T, config *tursoProviderConfig, database string) {
+	t.Helper()
+
+	authorized := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.Header.Get("Authorization") != "Bearer token-"+database {
+			http.Error(w, `{"error":"Unauthorized"}`, http.StatusUnauthorized)
+			return false
+		}
+		return true
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v2/pipeline", func(w http.ResponseWriter, r *http.Request) {
+		if authorized(w, r) {
+			f.pipeline(w, r)
+		}
+	})
+	mux.HandleFunc("GET /dump", func(w http.ResponseWriter, r *http.Request) {
+		if authorized(w, r) {
+			f.serveDump(w)
+		}
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() {
+		// Streams are closed by the client in the background.
+		for i := 0; i < 100 && f.openStreams() != 0; i++ {
+			time.Sleep(10 * time.Millisecond)
+		}
+		if n := f.openStreams(); n != 0 {
+			t.Errorf("expected all streams to be closed, got %d open", n)
+		}
+		server.Close()
+	})
+	config.DatabaseURL = func(hostname string) string {
+		return server.URL
+	}
+}
+
+func (f *fakeLibsql) openStreams() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.streams)
+}
+
+// fakeStmt is a statement with positional arguments.
+type fakeStmt struct {
+	SQL      string      `json:"sql"`
+	Args     []fakeValue `json:"args"`
+	WantRows bool        `json:"want_rows"`
+}
+
+// fakeResult is the result of a statement.
+type fakeResult struct {
+	Cols             []fakeCol     `json:"cols"`
+	Rows             [][]fakeValue `json:"rows"`
+	AffectedRowCount int           `json:"affected_row_count"`
+}
+
+type fakeCol struct {
+	Name string `json:"name"`
+}
+
+// fakeValue is a value as encoded by Hrana. Integers are encoded as strings.
+type fakeValue struct {
+	Type  string `json:"type"`
+	Value any    `json:"value,omitempty"`
+}
+
+func textValue(s string) fakeValue {
+	return fakeValue{Type: "text", Value: s}
+}
+
+func integerValue(i int64) fakeValue {
+	return fakeValue{Type: "integer", Value: strconv.FormatInt(i, 10)}
+}
+
+func floatValue(f float64) fakeValue {
+	return fakeValue{Type: "float", Value: f}
+}
+
+func nullValue() fakeValue {
+	return fakeValue{Type: "null"}
+}
+
+// text returns the value of a text value, or the empty string for other
+// values.
+func (v fakeValue) text() string {
+	s, _ := v.Value.(string)
+	return s
+}
+
+// fakeCondition is the condition of a batch step.
+type fakeCondition struct {
+	Type  string          `json:"type"`
+	Step  int             `json:"step"`
+	Cond  *fakeCondition  `json:"cond"`
+	Conds []fakeCondition `json:"conds"`
+}
+
+func (c *fakeCondition) eval(results []*fakeResult, errs []*fakeError) bool {
+	if c == nil {
+		return true
+	}
+	switch c.Type {
+	case "ok":
+		return results[c.Step] != nil
+	case "error":
+		return errs[c.Step] != nil
+	case "not":
+		return !c.Cond.eval(results, errs)
+	case "and":
+		for _, cond := range c.Conds {
+			if !cond.eval(results, errs) {
+				return false
+			}
+		}
+		return true
+	case "or":
+		for _, cond := range c.Conds {
+			if cond.eval(results, errs) {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
+type fakeError struct {
+	Message string `json:"message"`
+}
+
+type fakePipelineRequest struct {
+	Baton    *string `json:"baton"`
+	Requests []struct {
+		Type  string    `json:"type"`
+		Stmt  *fakeStmt `json:"stmt"`
+		Batch *struct {
+			Steps []struct {
+				Stmt      fakeStmt       `json:"stmt"`
+				Condition *fakeCondition `json:"condition"`
+			} `json:"steps"`
+		} `json:"batch"`
+	} `json:"requests"`
+}
+
+type fakeStreamResult struct {
+	Type     string `json:"type"`
+	Response *struct {
+		Type   string `json:"type"`
+		Result any    `json:"result,omitempty"`
+	} `json:"response,omitempty"`
+	Error *fakeError `json:"error,omitempty"`
+}
+
+func (f *fakeLibsql) pipeline(w http.ResponseWriter, r *http.Request) {
+	var req fakePipelineRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	conn := &fakeLibsqlConn{db: f}
+	if req.Baton != nil {
+		var ok bool
+		conn, ok = f.streams[*req.Baton]
+		if !ok {
+			http.Error(w, `{"error":"Invalid baton"}`, http.StatusBadRequest)
+			return
+		}
+		delete(f.streams, *req.Baton)
+	}
+
+	var res struct {
+		Baton   *string            `json:"baton"`
+		Results []fakeStreamResult `json:"results"`
+	}
+	closed := false
+	for _, streamReq := range req.Requests {
+		result := fakeStreamResult{Type: "ok"}
+		result.Response = &struct {
+			Type   string `json:"type"`
+			Result any    `json:"result,omitempty"`
+		}{Type: streamReq.Type}
+		var err error
+		switch streamReq.Type {
+		case "execute":
+			result.Response.Result, err = conn.execute(*streamReq.Stmt)
+		case "batch":
+			var batch struct {
+				StepResults []*fakeResult `json:"step_results"`
+				StepErrors  []*fakeError  `json:"step_errors"`
+			}
+			for _, step := range streamReq.Batch.Steps {
+				var stepResult *fakeResult
+				var stepErr *fakeError
+				if step.Condition.eval(batch.StepResults, batch.StepErrors) {
+					stepResult, err = conn.execute(step.Stmt)
+					if err != nil {
+						stepResult, stepErr = nil, &fakeError{Message: err.Error()}
+					}
+				}
+				batch.StepResults = append(batch.StepResults, stepResult)
+				batch.StepErrors = append(batch.StepErrors, stepErr)
+			}
+			result.Response.Result, err = batch, nil
+		case "close":
+			conn.rollback()
+			closed = true
+		default:
+			http.Error(w, `{"error":"Unsupported request type"}`, http.StatusBadRequest)
+			return
+		}
+		if err != nil {
+			result = fakeStreamResult{Type: "error", Error: &fakeError{Message: err.Error()}}
+		}
+		res.Results = append(res.Results, result)
+	}
+
+	if !closed {
+		f.batons++
+		baton := strconv.Itoa(f.batons)
+		f.streams[baton] = conn
+		res.Baton = &baton
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+func (f *fakeLibsql) serveDump(w http.ResponseWriter) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.failDump {
+		http.Error(w, "injected failure", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = io.WriteString(w, f.dump)
+}
+
+// fakeLibsqlConn is the connection of a stream to a fakeLibsql. Changes made
+// in a transaction are only visible to other connections once committed. The
+// database must be locked while using it.
+type fakeLibsqlConn struct {
+	db *fakeLibsql
+
+	inTx       bool
+	tracked    [][2]string
+	statements []string
+}
+
+// quotedName returns the first double-quoted identifier in sql.
+func quotedName(sql string) string {
+	_, rest, _ := strings.Cut(sql, `"`)
+	name, _, _ := strings.Cut(rest, `"`)
+	return name
+}
+
+// execute executes a statement. Statements which are not used to track
+// migrations are treated as statements of a migration script in a
+// transaction, and as queries otherwise.
+func (c *fakeLibsqlConn) execute(stmt fakeStmt) (*fakeResult, error) {
+	res := &fakeResult{Rows: [][]fakeValue{}}
+	switch {
+	case strings.HasPrefix(stmt.SQL, "SELECT name FROM sqlite_master"):
+		res.Cols = []fakeCol{{Name: "name"}}
+		if name := stmt.Args[0].text(); c.db.tables[name] {
+			res.Rows = append(res.Rows, []fakeValue{textValue(name)})
+		}
+	case strings.HasPrefix(stmt.SQL, "CREATE TABLE IF NOT EXISTS"):
+		c.db.tables[quotedName(stmt.SQL)] = true
+	case strings.HasPrefix(stmt.SQL, "SELECT id, checksum FROM"):
+		if !c.db.tables[quotedName(stmt.SQL)] {
+			return nil, errors.New("no such table")
+		}
+		res.Cols = []fakeCol{{Name: "id"}, {Name: "checksum"}}
+		for _, row := range c.db.tracked {
+			res.Rows = append(res.Rows, []fakeValue{textValue(row[0]), textValue(row[1])})
+		}
+	case strings.HasPrefix(stmt.SQL, "INSERT INTO") && quotedName(stmt.SQL) != "":
+		row := [2]string{stmt.Args[0].text(), stmt.Args[1].text()}
+		if !c.inTx {
+			c.db.tracked = append(c.db.tracked, row)
+			break
+		}
+		c.tracked = append(c.tracked, row)
+	case stmt.SQL == "BEGIN":
+		if c.inTx {
+			return nil, errors.New("cannot start a transaction within a transaction")
+		}
+		c.inTx = true
+	case stmt.SQL == "COMMIT":
+		if !c.inTx {
+			return nil, errors.New("cannot commit - no transaction is active")
+		}
+		c.db.tracked = append(c.db.tracked, c.tracked...)
+		c.db.statements = append(c.db.statements, c.statements...)
+		c.rollback()
+	case stmt.SQL == "ROLLBACK":
+		if !c.inTx {
+			return nil, errors.New("cannot rollback - no transaction is active")
+		}
+		c.rollback()
+	case c.inTx:
+		if c.db.failOn[stmt.SQL] {
+			return nil, errors.New("injected failure")
+		}
+		c.statements = append(c.statements, stmt.SQL)
+	default:
+		c.db.queries = append(c.db.queries, stmt)
+		res, ok := c.db.results[stmt.SQL]
+		if !ok {
+			return nil, errors.New("unsupported statement: " + stmt.SQL)
+		}
+		return res, nil
+	}
+	return res, nil
+}
+
+func (c *fakeLibsqlConn) rollback() {
+	c.inTx = false
+	c.tracked = nil
+	c.statements = nil
+}
@@ -0,0 +1,620 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+)
+
+// fakeTurso is an in-memory stand-in for the Turso Platform API, served by the
+// generated tursoclient server. Operations not implemented here return 501.
+type fakeTurso struct {
+	tursoclient.UnimplementedHandler
+
+	mu        sync.Mutex
+	groups    map[string]*tursoclient.BaseGroup
+	databases map[string]*tursoclient.Database
+	instances map[string][]tursoclient.Instance
+
+	// created records the request each database was created with.
+	created map[string]tursoclient.CreateDatabaseInput
+	// dumps holds uploaded database dumps by URL.
+	dumps map[string]string
+	// rows holds the number of rows in each table of the databases served by
+	// serveDatabases. Databases seeded from a dump or another database get
+	// the tables of the seed, see fakeDump.
+	rows map[string]map[string]int64
+	// loseRowsOn makes the database of the name miss the last row of each
+	// table when it is seeded, as if the copy was incomplete.
+	loseRowsOn map[string]bool
+
+	// calls records each operation and its arguments, e.g.
+	// "AddLocationToGroup test dfw", in the order they were received.
+	calls []string
+
+	// failOn makes the operation matching the call string fail with a server
+	// error instead of being applied.
+	failOn map[string]bool
+	// hangOn makes the operation matching the call string wait until its
+	// request is cancelled, as if it did not finish before a timeout.
+	hangOn map[string]bool
+}
+
+func newFakeTurso() *fakeTurso {
+	return &fakeTurso{
+		groups:     make(map[string]*tursoclient.BaseGroup),
+		databases:  make(map[string]*tursoclient.Database),
+		instances:  make(map[string][]tursoclient.Instance),
+		created:    make(map[string]tursoclient.CreateDatabaseInput),
+		dumps:      make(map[string]string),
+		rows:       make(map[string]map[string]int64),
+		loseRowsOn: make(map[string]bool),
+		failOn:     make(map[string]bool),
+		hangOn:     make(map[string]bool),
+	}
+}
+
+// start serves the fake API and returns a provider config pointing at it.
+func (f *fakeTurso) start(t *testing.T) *tursoProviderConfig {
+	t.Helper()
+
+	server, err := tursoclient.NewServer(f)
+	if err != nil {
+		t.Fatalf("error creating fake server: %v", err)
+	}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	client, err := tursoclient.NewClient(httpServer.URL, tursoclient.WithClient(httpServer.Client()))
+	if err != nil {
+		t.Fatalf("error creating client: %v", err)
+	}
+	return &tursoProviderConfig{
+		Organization: "test-org",
+		Client:       client,
+		Parallelism:  defaultParallelism,
+	}
+}
+
+// serveDatabases serves every database of the fake over the Hrana HTTP
+// protocol, answering the queries which list its tables and count their rows,
+// and serves its dump, see fakeDump. Only the token minted for the database is
+// accepted. Exports are recorded as "Dump name" calls.
+func (f *fakeTurso) serveDatabases(t *testing.T, config *tursoProviderConfig) {
+	t.Helper()
+
+	var mu sync.Mutex
+	conns := map[string]*fakeLibsql{}
+	mux := http.NewServeMux()
+	authorized := func(w http.ResponseWriter, r *http.Request) bool {
+		name := r.PathValue("name")
+		if r.Header.Get("Authorization") != "Bearer token-"+name {
+			http.Error(w, `{"error":"Unauthorized"}`, http.StatusUnauthorized)
+			return false
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if _, ok := f.databases[name]; !ok {
+			http.NotFound(w, r)
+			return false
+		}
+		return true
+	}
+	mux.HandleFunc("POST /{name}/v2/pipeline", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		name := r.PathValue("name")
+		mu.Lock()
+		db, ok := conns[name]
+		if !ok {
+			db = newFakeLibsql()
+			conns[name] = db
+		}
+		mu.Unlock()
+
+		f.mu.Lock()
+		rows := maps.Clone(f.rows[name])
+		f.mu.Unlock()
+		db.mu.Lock()
+		tables := &fakeResult{Cols: []fakeCol{{Name: "name"}}, Rows: [][]fakeValue{}}
+		db.results = map[string]*fakeResult{listTablesSQL: tables}
+		for _, table := range slices.Sorted(maps.Keys(rows)) {
+			tables.Rows = append(tables.Rows, []fakeValue{textValue(table)})
+			db.results["SELECT count(*) FROM "+quoteIdentifier(table)] = &fakeResult{
+				Cols: []fakeCol{{Name: "count(*)"}},
+				Rows: [][]fakeValue{{integerValue(rows[table])}},
+			}
+		}
+		db.mu.Unlock()
+		db.pipeline(w, r)
+	})
+	mux.HandleFunc("GET /{name}/dump", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		name := r.PathValue("name")
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if err := f.call("Dump", name); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = io.WriteString(w, fakeDump(f.rows[name]))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	config.DatabaseURL = func(hostname string) string {
+		return server.URL + "/" + strings.TrimSuffix(hostname, "-test-org.turso.io")
+	}
+}
+
+// fakeDump returns the dump of a database with the given number of rows in
+// each table, which lists each table and its row count as a comment.
+func fakeDump(rows map[string]int64) string {
+	var dump strings.Builder
+	for _, table := range slices.Sorted(maps.Keys(rows)) {
+		fmt.Fprintf(&dump, "-- %s %d\n", table, rows[table])
+	}
+	return dump.String()
+}
+
+// parseFakeDump returns the number of rows in each table of a dump written by
+// fakeDump. Other lines are ignored.
+func parseFakeDump(dump string) map[string]int64 {
+	rows := map[string]int64{}
+	for _, line := range strings.Split(dump, "\n") {
+		var table string
+		var n int64
+		if _, err := fmt.Sscanf(line, "-- %s %d", &table, &n); err == nil {
+			rows[table] = n
+		}
+	}
+	return rows
+}
+
+// call records an operation and reports whether it should fail.
+func (f *fakeTurso) call(op string, args ...string) error {
+	c := op
+	for _, arg := range args {
+		c += " " + arg
+	}
+	f.calls = append(f.calls, c)
+	if f.failOn[c] {
+		return fmt.Errorf("injected failure: %s", c)
+	}
+	return nil
+}
+
+// hang waits until ctx is done if the call matches hangOn, returning its
+// error. It must be called without holding the lock.
+func (f *fakeTurso) hang(ctx context.Context, op string, args ...string) error {
+	c := strings.Join(append([]string{op}, args...), " ")
+	f.mu.Lock()
+	hang := f.hangOn[c]
+	f.mu.Unlock()
+	if !hang {
+		return nil
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (f *fakeTurso) callsTo(op string) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var calls []string
+	for _, c := range f.calls {
+		if strings.HasPrefix(c, op+" ") {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (f *fakeTurso) CreateGroup(ctx context.Context, req *tursoclient.NewGroup, params tursoclient.CreateGroupParams) (tursoclient.CreateGroupRes, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.call("CreateGroup", req.Name); err != nil {
+		return nil, err
+	}
+	if _, ok := f.groups[req.Name]; ok {
+		return &tursoclient.CreateGroupConflict{Error: tursoclient.NewOptString("group already exists")}, nil
+	}
+	group := &tursoclient.BaseGroup{
+		Name:      tursoclient.NewOptString(req.Name),
+		Version:   tursoclient.NewOptString("v0.24.0"),
+		UUID:      tursoclient.NewOptString("uuid-" + req.Name),
+		Locations: []string{req.Location},
+		Primary:   tursoclient.NewOptString(req.Location),
+		Archived:  tursoclient.NewOptBool(false),
+	}
+	f.groups[req.Name] = group
+	return &tursoclient.CreateGroupOK{Group: tursoclient.NewOptBaseGroup(*group)}, nil
+}
+
+func (f *fakeTurso) GetGroup(ctx context.Context, params tursoclient.GetGroupParams) (tursoclient.GetGroupRes, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.call("GetGroup", params.GroupName); err != nil {
+		return nil, err
+	}
+	group, ok := f.groups[params.GroupName]
+	if !ok {
+		return &tursoclient.GroupNotFoundResponse{Error: tursoclient.NewOptString("group not found")}, nil
+	}
+	return &tursoclient.GetGroupOK{Group: tursoclient.NewOptBaseGroup(*group)}, nil
+}
+
+func (f *fakeTurso) ListGroups(ctx context.Context, params tursoclient.ListGroupsParams) (*tursoclient.ListGroupsOK, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.call("ListGroups"); err != nil {
+		return nil, err
+	}
+	groups := []tursoclient.BaseGroup{}
+	for _, group := range f.groups {
+		groups = append(groups, *group)
+	}
+	slices.SortFunc(groups, func(a, b tursoclient.BaseGroup) int {
+		return strings.Compare(a.Name.Value, b.Name.Value)
+	})
+	return &tursoclient.ListGroupsOK{Groups: groups}, nil
+}
+
+func (f *fakeTurso) DeleteGroup(ctx context.Context, params tursoclient.DeleteGroupParams) (tursoclient.DeleteGroupRes, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.call("DeleteGroup", params.GroupName); err != nil {
+		return nil, err
+	}
+	group, ok := f.groups[params.GroupName]
+	if !ok {
+		return &tursoclient.GroupNotFoundResponse{Error: tursoclient.NewOptString("group not found")}, nil
+	}
+	delete(f.groups, params.GroupName)
+	return &tursoclient.DeleteGroupOK{Group: tursoclient.NewOptBaseGroup(*group)}, nil
+}
+
+func (f *fakeTurso) AddLocationToGroup(ctx context.Context, params tursoclient.AddLocationToGroupParams) (tursoclient.AddLocationToGroupRes, error) {
+	if err := f.hang(ctx, "AddLocationToGroup", params.GroupName, params.Location); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.call("AddLocationToGroup", params.GroupName, params.Location); err != nil {
+		return nil, err
+	}
+	group, ok := f.groups[params.GroupName]
+	if !ok {
+		return &tursoclient.GroupNotFoundResponse{Error: tursoclient.NewOptString("group not found")}, nil
+	}
+	if !slices.Contains(group.Locations, params.Location) {
+		group.Locations = append(group.Locations, params.Location)
+	}
+	return &tursoclient.AddLocationToGroupOK{Group: tursoclient.NewOptBaseGroup(*group)}, nil
+}
+
+func (f *fakeTurso) RemoveLocationFromGroup(ctx context.Context, params tursoclient.RemoveLocationFromGroupParams) (tursoclient.RemoveLocationFromGroupRes, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.call("RemoveLocationFromGroup", params.GroupName, params.Location); err != nil {
+		return nil, err
+	}
+	group, ok := f.groups[params.GroupName]
+	if !ok {
+		return &tursoclient.GroupNotFoundResponse{Error: tursoclient.NewOptString("group not found")}, nil
+	}
+	if params.Location == group.Primary.Value {
+		return &tursoclient.RemoveLocationFromGroupBadRequest{Error: tursoclient.NewOptString("cannot remove primary location")}, nil
+	}
+	group.Locations = slices.DeleteFunc(group.Locations, func(location string) bool {
+		return location == params.Location
+	})
+	return &tursoclient.RemoveLocationFromGroupOK{Group: tursoclient.NewOptBaseGroup(*group)}, nil
+}
+
+func (f *fakeTurso) InvalidateGroupTokens(ctx context.Context, params tursoclient.InvalidateGroupTokensParams) (tursoclient.InvalidateGroupTokensRes, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.call("InvalidateGroupTokens", params.GroupName); err != nil {
+		return nil, err
+	}
+	if _, ok := f.groups[params.GroupName]; !ok {
+		return &tursoclient.GroupNotFoundResponse{Error: tursoclient.NewOptString("group not found")}, nil
+	}
+	return &tursoclient.InvalidateGroupTokensOK{}, nil
+}
+
+func (f *fakeTurso) UnarchiveGroup(ctx context.Context, params tursoclient.UnarchiveGroupParams) (tursoclient.UnarchiveGroupRes, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.call("UnarchiveGroup", params.GroupName); err != nil {
+		return nil, err
+	}
+	group, ok := f.groups[params.GroupName]
+	if !ok {
+		return &tursoclient.GroupNotFoundResponse{Error: tursoclient.NewOptString("group not found")}, nil
+	}
+	group.Archived = tursoclient.NewOptBool(false)
+	return &tursoclient.UnarchiveGroupOK{Group: tursoclient.NewOptBaseGroup(*group)}, nil
+}
+
+// UpdateGroupDatabases upgrades the group to the next fake libSQL server
+// version.
+func (f *fakeTurso) UpdateGroupDatabases(ctx context.Context, params tursoclient.UpdateGroupDatabasesParams) (tursoclient.UpdateGroupDatabasesRes, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.call("UpdateGroupDatabases", params.GroupName); err != nil {
+		return nil, err
+	}
+	group, ok := f.groups[params.GroupName]
+	if !ok {
+		return &tursoclient.GroupNotFoundResponse{Error: tursoclient.NewOptString("group not found")}, nil
+	}
+	group.Version = tursoclient.NewOptString("v0.25.0")
+	return &tursoclient.UpdateGroupDatabasesOK{}, nil
+}
+
+func (f *fakeTurso) CreateDatabase(ctx context.Context, req *tursoclient.CreateDatabaseInput, params tursoclient.CreateDatabaseParams) (tursoclient.CreateDatabaseRes, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.call("CreateDatabase", req.Name); err != nil {
+		return nil, err
+	}
+	if _, ok := f.groups[req.Group]; !ok {
+		return &tursoclient.CreateDatabaseBadRequest{Error: tursoclient.NewOptString("group not found")}, nil
+	}
+	if _, ok := f.databases[req.Name]; ok {
+		return &tursoclient.CreateDatabaseConflict{Error: tursoclient.NewOptString("database already exists")}, nil
+	}
+	rows := map[string]int64{}
+	if seed := req.Seed.Value; req.Seed.Set && seed.Type.Value == "dump" {
+		dump, ok := f.dumps[seed.URL.Value]
+		if !ok {
+			return &tursoclient.CreateDatabaseBadRequest{Error: tursoclient.NewOptString("dump not found")}, nil
+		}
+		rows = parseFakeDump(dump)
+	} else if req.Seed.Set && seed.Type.Value == "database" {
+		if source, ok := f.databases[seed.Name.Value]; ok && source.Group.Value != req.Group {
+			return &tursoclient.CreateDatabaseBadRequest{Error: tursoclient.NewOptString("seed database must be in the same group")}, nil
+		}
+		maps.Copy(rows, f.rows[seed.Name.Value])
+	}
+	if f.loseRowsOn[req.Name] {
+		for table, n := range rows {
+			rows[table] = max(n-1, 0)
+		}
+	}
+	f.created[req.Name] = *req
+	f.rows[req.Name] = rows
+	db := f.addDatabaseLocked(req.Name, req.Group, req.Name+"-test-org.turso.io")
+	db.IsSchema = tursoclient.NewOptBool(req.IsSchema.Value)
+	if req.Schema.Set {
+		db.Schema = tursoclient.NewOptNilString(req.Schema.Value)
+	}
+	return &tursoclient.CreateDatabaseOK{Database: tursoclient.NewOptCreateDatabaseOutput(tursoclient.CreateDatabaseOutput{
+		DbId:     tursoclient.NewOptDbId(tursoclient.DbId(db.DbId.Value)),
+		Hostname: tursoclient.NewOptHostname(tursoclient.Hostname(db.Hostname.Value)),
+		Name:     tursoclient.NewOptName(tursoclient.Name(db.Name.Value)),
+	})}, nil
+}
+
+func (f *fakeTurso) DeleteDatabase(ctx context.Context, params tursoclient.DeleteDatabaseParams) (tursoclient.DeleteDatabaseRes, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.call("DeleteDatabase", params.DatabaseName); err != nil {
+		return nil, err
+	}
+	if _, ok := f.databases[params.DatabaseName]; !ok {
+		return &tursoclient.DatabaseNotFoundResponse{Error: tursoclient.NewOptString("database not found")}, nil
+	}
+	delete(f.databases, params.DatabaseName)
+	delete(f.instances, params.DatabaseName)
+	delete(f.rows, params.DatabaseName)
+	return &tursoclient.DeleteDatabaseOK{Database: tursoclient.NewOptString(params.DatabaseName)}, nil
+}
+
+func (f *fakeTurso) InvalidateDatabaseTokens(ctx context.Context, params tursoclient.InvalidateDatabaseTokensParams) (tursoclient.InvalidateDatabaseTokensRes, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.call("InvalidateDatabaseTokens", params.DatabaseName); err != nil {
+		return nil, err
+	}
+	if _, ok := f.databases[params.DatabaseName]; !ok {
+		return &tursoclient.DatabaseNotFoundResponse{Error: tursoclient.NewOptString("database not found")}, nil
+	}
+	return &tursoclient.InvalidateDatabaseTokensOK{}, nil
+}
+
+func (f *fakeTurso) GetDatabaseConfiguration(ctx context.Context, params tursoclient.GetDatabaseConfigurationParams) (*tursoclient.DatabaseConfigurationResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.call("GetDatabaseConfiguration", params.DatabaseName); err != nil {
+		return nil, err
+	}
+	db, ok := f.databases[params.DatabaseName]
+	if !ok {
+		return nil, fmt.Errorf("database not found: %s", params.DatabaseName)
+	}
+	return &tursoclient.DatabaseConfigurationResponse{
+		SizeLimit:   tursoclient.NewOptString(f.created[params.DatabaseName].SizeLimit.Value),
+		AllowAttach: db.AllowAttach,
+		BlockReads:  db.BlockReads,
+		BlockWrites: db.BlockWrites,
+	}, nil
+}
+
+func (f *fakeTurso) UpdateDatabaseConfiguration(ctx context.Context, req *tursoclient.DatabaseConfigurationInput, params tursoclient.UpdateDatabaseConfigurationParams) (*tursoclient.DatabaseConfigurationResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.call("UpdateDatabaseConfiguration", params.DatabaseName); err != nil {
+		return nil, err
+	}
+	db, ok := f.databases[params.DatabaseName]
+	if !ok {
+		return nil, fmt.Errorf("database not found: %s", params.DatabaseName)
+	}
+	if req.AllowAttach.Set {
+		db.AllowAttach = req.AllowAttach
+	}
+	if req.BlockReads.Set {
+		db.BlockReads = req.BlockReads
+	}
+	if req.BlockWrites.Set {
+		db.BlockWrites = req.BlockWrites
+	}
+	return &tursoclient.DatabaseConfigurationResponse{
+		SizeLimit:   req.SizeLimit,
+		AllowAttach: db.AllowAttach,
+		BlockReads:  db.BlockReads,
+		BlockWrites: db.BlockWrites,
+	}, nil
+}
+
+func (f *fakeTurso) UploadDatabaseDump(ctx context.Context, req *tursoclient.UploadDatabaseDumpReq, params tursoclient.UploadDatabaseDumpParams) (*tursoclient.UploadDatabaseDumpOK, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.call("UploadDatabaseDump", req.File.Name); err != nil {
+		return nil, err
+	}
+	contents, err := io.ReadAll(req.File.File)
+	if err != nil {
+		return nil, err
+	}
+	dumpURL := url.URL{Scheme: "https", Host: "dumps.example.com", Path: fmt.Sprintf("/%d/%s", len(f.dumps), req.File.Name)}
+	f.dumps[dumpURL.String()] = string(contents)
+	return &tursoclient.UploadDatabaseDumpOK{DumpURL: tursoclient.NewOptURI(dumpURL)}, nil
+}
+
+func (f *fakeTurso) GetDatabase(ctx context.Context, params tursoclient.GetDatabaseParams) (tursoclient.GetDatabaseRes, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.call("GetDatabase", params.DatabaseName); err != nil {
+		return nil, err
+	}
+	db, ok := f.databases[params.DatabaseName]
+	if !ok {
+		return &tursoclient.DatabaseNotFoundResponse{Error: tursoclient.NewOptString("database not found")}, nil
+	}
+	return &tursoclient.GetDatabaseOK{Database: tursoclient.NewOptDatabase(*db)}, nil
+}
+
+func (f *fakeTurso) ListDatabases(ctx context.Context, params tursoclient.ListDatabasesParams) (*tursoclient.ListDatabasesOK, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.call("ListDatabases"); err != nil {
+		return nil, err
+	}
+	databases := []tursoclient.Database{}
+	for _, db := range f.databases {
+		if params.Group.Set && db.Group.Value != params.Group.Value {
+			continue
+		}
+		if params.Schema.Set && db.Schema.Value != params.Schema.Value {
+			continue
+		}
+		databases = append(databases, *db)
+	}
+	slices.SortFunc(databases, func(a, b tursoclient.Database) int {
+		return strings.Compare(a.Name.Value, b.Name.Value)
+	})
+	return &tursoclient.ListDatabasesOK{Databases: databases}, nil
+}
+
+func (f *fakeTurso) ListDatabaseInstances(ctx context.Context, params tursoclient.ListDatabaseInstancesParams) (*tursoclient.ListDatabaseInstancesOK, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.call("ListDatabaseInstances", params.DatabaseName); err != nil {
+		return nil, err
+	}
+	return &tursoclient.ListDatabaseInstancesOK{Instances: slices.Clone(f.instances[params.DatabaseName])}, nil
+}
+
+func (f *fakeTurso) CreateDatabaseToken(ctx context.Context, req tursoclient.OptCreateTokenInput, params tursoclient.CreateDatabaseTokenParams) (tursoclient.CreateDatabaseTokenRes, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.call("CreateDatabaseToken", params.DatabaseName, string(params.Authorization.Value), params.Expiration.Value); err != nil {
+		return nil, err
+	}
+	if _, ok := f.databases[params.DatabaseName]; !ok {
+		return &tursoclient.DatabaseNotFoundResponse{Error: tursoclient.NewOptString("database not found")}, nil
+	}
+	return &tursoclient.CreateDatabaseTokenOK{Jwt: tursoclient.NewOptString("token-" + params.DatabaseName)}, nil
+}
+
+// addDatabase adds a database in the group to the fake, without instances.
+func (f *fakeTurso) addDatabase(name, group, hostname string) *tursoclient.Database {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.addDatabaseLocked(name, group, hostname)
+}
+
+func (f *fakeTurso) addDatabaseLocked(name, group, hostname string) *tursoclient.Database {
+	g := f.groups[group]
+	db := &tursoclient.Database{
+		Name:          tursoclient.NewOptString(name),
+		DbId:          tursoclient.NewOptString("id-" + name),
+		Hostname:      tursoclient.NewOptString(hostname),
+		Regions:       slices.Clone(g.Locations),
+		PrimaryRegion: g.Primary,
+		Type:          tursoclient.NewOptString("logical"),
+		Version:       g.Version,
+		Group:         tursoclient.NewOptString(group),
+		BlockReads:    tursoclient.NewOptBool(false),
+		BlockWrites:   tursoclient.NewOptBool(false),
+		AllowAttach:   tursoclient.NewOptBool(false),
+		IsSchema:      tursoclient.NewOptBool(false),
+		Archived:      tursoclient.NewOptBool(false),
+	}
+	f.databases[name] = db
+	return db
+}
+
+// addInstance adds an instance of the database in the region.
+func (f *fakeTurso) addInstance(database, region string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.instances[database] = append(f.instances[database], tursoclient.Instance{
+		UUID:     tursoclient.NewOptString("uuid-" + database + "-" + region),
+		Name:     tursoclient.NewOptString(region),
+		Type:     tursoclient.NewOptInstanceType(tursoclient.InstanceTypeReplica),
+		Region:   tursoclient.NewOptString(region),
+		Hostname: tursoclient.NewOptString(region + "." + f.databases[database].Hostname.Value),
+	})
+}
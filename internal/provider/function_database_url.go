@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &DatabaseURLFunction{}
+
+func NewDatabaseURLFunction() function.Function {
+	return &DatabaseURLFunction{}
+}
+
+// DatabaseURLFunction returns the URL of a database from its name and
+// organization.
+type DatabaseURLFunction struct{}
+
+func (f *DatabaseURLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "database_url"
+}
+
+func (f *DatabaseURLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Returns the URL of a database",
+		Description:         "Returns the libSQL or HTTP URL of a database from its name and the name of its organization, without calling the Turso API.",
+		MarkdownDescription: "Returns the libSQL or HTTP URL of a database from its name and the name of its organization, without calling the Turso API.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name of the database.",
+			},
+			function.StringParameter{
+				Name:        "organization",
+				Description: "The name of the organization the database belongs to.",
+			},
+			function.StringParameter{
+				Name:                "kind",
+				Description:         "The kind of URL to return: libsql for libSQL clients, http for the HTTP API, or hostname for the bare hostname.",
+				MarkdownDescription: "The kind of URL to return: `libsql` for libSQL clients, `http` for the HTTP API, or `hostname` for the bare hostname.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DatabaseURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, organization, kind string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &name, &organization, &kind))
+	if resp.Error != nil {
+		return
+	}
+	if name == "" {
+		resp.Error = function.NewArgumentFuncError(0, "name must not be empty")
+		return
+	}
+	if organization == "" {
+		resp.Error = function.NewArgumentFuncError(1, "organization must not be empty")
+		return
+	}
+
+	hostname := databaseHostname(name, organization)
+	var result string
+	switch kind {
+	case "libsql":
+		result = libsqlURL(hostname)
+	case "http":
+		result = httpURL(hostname)
+	case "hostname":
+		result = hostname
+	default:
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf(`kind must be "libsql", "http" or "hostname", got %q`, kind))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runFunction runs the function with the arguments, and returns its result
+// or error.
+func runFunction(t *testing.T, f function.Function, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	ctx := context.Background()
+	var def function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &def)
+	if def.Diagnostics.HasError() {
+		t.Fatalf("error defining function: %v", def.Diagnostics)
+	}
+	result, err := def.Definition.Return.NewResultData(ctx)
+	if err != nil {
+		t.Fatalf("error creating result: %v", err)
+	}
+	resp := function.RunResponse{Result: result}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestDatabaseURLFunction(t *testing.T) {
+	tests := []struct {
+		kind string
+		want string
+		err  string
+	}{
+		{kind: "libsql", want: "libsql://app-acme-corp.turso.io"},
+		{kind: "http", want: "https://app-acme-corp.turso.io"},
+		{kind: "hostname", want: "app-acme-corp.turso.io"},
+		{kind: "wss", err: `kind must be "libsql", "http" or "hostname", got "wss"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			got, err := runFunction(t, NewDatabaseURLFunction(), types.StringValue("app"), types.StringValue("acme-corp"), types.StringValue(tt.kind))
+			if tt.err != "" {
+				if err == nil || err.Text != tt.err || err.FunctionArgument == nil || *err.FunctionArgument != 2 {
+					t.Errorf("expected error %q for the kind argument, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(types.StringValue(tt.want)) {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &JWTClaimsFunction{}
+
+func NewJWTClaimsFunction() function.Function {
+	return &JWTClaimsFunction{}
+}
+
+// JWTClaimsFunction decodes the claims of a database or group token.
+type JWTClaimsFunction struct{}
+
+type jwtClaims struct {
+	Authorization types.String `tfsdk:"authorization"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+	IssuedAt      types.String `tfsdk:"issued_at"`
+	Id            types.String `tfsdk:"id"`
+	Permissions   types.Map    `tfsdk:"permissions"`
+	Claims        types.String `tfsdk:"claims"`
+}
+
+// tokenAuthorizations maps the authorization levels used in token claims to
+// those used by the Turso API.
+var tokenAuthorizations = map[string]string{
+	"ro": "read-only",
+	"rw": "full-access",
+}
+
+func (f *JWTClaimsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "jwt_claims"
+}
+
+func (f *JWTClaimsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Decodes the claims of a token",
+		Description:         "Decodes the claims of a database or group token, such as its expiry and authorization. The signature of the token is not verified, so the claims must not be trusted for access control. The authorization and the keys of permissions are read-only or full-access. Claims which are not set are null, and all claims are returned as JSON in claims.",
+		MarkdownDescription: "Decodes the claims of a database or group token, such as its expiry and authorization. **The signature of the token is not verified**, so the claims must not be trusted for access control. The `authorization` and the keys of `permissions` are `read-only` or `full-access`. Claims which are not set are null, and all claims are returned as JSON in `claims`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "token",
+				Description: "The token to decode.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"authorization": types.StringType,
+				"expires_at":    types.StringType,
+				"issued_at":     types.StringType,
+				"id":            types.StringType,
+				"permissions":   types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
+				"claims":        types.StringType,
+			},
+		},
+	}
+}
+
+func (f *JWTClaimsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var token string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &token))
+	if resp.Error != nil {
+		return
+	}
+
+	claims, err := decodeJWTClaims(token)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, claims))
+}
+
+func decodeJWTClaims(token string) (jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return jwtClaims{}, errors.New("invalid token: expected three dot-separated parts")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return jwtClaims{}, fmt.Errorf("invalid token payload: %s", err)
+	}
+	var raw struct {
+		Authorization *string  `json:"a"`
+		ExpiresAt     *float64 `json:"exp"`
+		IssuedAt      *float64 `json:"iat"`
+		Id            *string  `json:"id"`
+		Permissions   map[string]struct {
+			Namespaces []string `json:"ns"`
+		} `json:"p"`
+	}
+	if err := json.Unmarshal(payload, &raw); err != nil {
+		return jwtClaims{}, fmt.Errorf("invalid token claims: %s", err)
+	}
+
+	authorization := func(a string) string {
+		if mapped, ok := tokenAuthorizations[a]; ok {
+			return mapped
+		}
+		return a
+	}
+	timestamp := func(t *float64) types.String {
+		if t == nil {
+			return types.StringNull()
+		}
+		return types.StringValue(time.Unix(int64(*t), 0).UTC().Format(time.RFC3339))
+	}
+
+	claims := jwtClaims{
+		Authorization: types.StringPointerValue(raw.Authorization),
+		ExpiresAt:     timestamp(raw.ExpiresAt),
+		IssuedAt:      timestamp(raw.IssuedAt),
+		Id:            types.StringPointerValue(raw.Id),
+		Permissions:   types.MapNull(types.ListType{ElemType: types.StringType}),
+		Claims:        types.StringValue(string(payload)),
+	}
+	if raw.Authorization != nil {
+		claims.Authorization = types.StringValue(authorization(*raw.Authorization))
+	}
+	if raw.Permissions != nil {
+		permissions := make(map[string]attr.Value, len(raw.Permissions))
+		for level, p := range raw.Permissions {
+			permissions[authorization(level)] = encodeStringList(p.Namespaces)
+		}
+		claims.Permissions = types.MapValueMust(types.ListType{ElemType: types.StringType}, permissions)
+	}
+	return claims, nil
+}
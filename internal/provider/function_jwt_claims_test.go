@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testToken(claims string) string {
+	return "eyJhbGciOiJFZERTQSIsInR5cCI6IkpXVCJ9." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".c2lnbmF0dXJl"
+}
+
+func TestJWTClaimsFunction(t *testing.T) {
+	const claims = `{"a":"ro","iat":1718000000,"exp":1718086400,"id":"db-id","p":{"rw":{"ns":["app","logs"]}}}`
+	got, err := runFunction(t, NewJWTClaimsFunction(), types.StringValue(testToken(claims)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	attributes := got.(types.Object).Attributes()
+	want := map[string]string{
+		"authorization": "read-only",
+		"issued_at":     "2024-06-10T06:13:20Z",
+		"expires_at":    "2024-06-11T06:13:20Z",
+		"id":            "db-id",
+		"claims":        claims,
+	}
+	for name, value := range want {
+		if !attributes[name].Equal(types.StringValue(value)) {
+			t.Errorf("expected %s %q, got %s", name, value, attributes[name])
+		}
+	}
+	permissions := attributes["permissions"].(types.Map).Elements()
+	if got := decodeStringList(permissions["full-access"].(types.List)); len(got) != 2 || got[0] != "app" || got[1] != "logs" {
+		t.Errorf("expected full-access permissions for app and logs, got %v", permissions)
+	}
+
+	// Tokens without an expiry have null claims.
+	got, err = runFunction(t, NewJWTClaimsFunction(), types.StringValue(testToken(`{"iat":1718000000}`)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	attributes = got.(types.Object).Attributes()
+	for _, name := range []string{"authorization", "expires_at", "id", "permissions"} {
+		if !attributes[name].IsNull() {
+			t.Errorf("expected %s to be null, got %s", name, attributes[name])
+		}
+	}
+
+	for _, token := range []string{"not-a-token", "a.!!!.c", testToken("[]")} {
+		if _, err := runFunction(t, NewJWTClaimsFunction(), types.StringValue(token)); err == nil {
+			t.Errorf("expected error decoding %q", token)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseDatabaseURLFunction{}
+
+func NewParseDatabaseURLFunction() function.Function {
+	return &ParseDatabaseURLFunction{}
+}
+
+// ParseDatabaseURLFunction parses a database URL or connection string.
+type ParseDatabaseURLFunction struct{}
+
+type parsedDatabaseURL struct {
+	Scheme    types.String `tfsdk:"scheme"`
+	Hostname  types.String `tfsdk:"hostname"`
+	AuthToken types.String `tfsdk:"auth_token"`
+	LibsqlURL types.String `tfsdk:"libsql_url"`
+	HTTPURL   types.String `tfsdk:"http_url"`
+}
+
+func (f *ParseDatabaseURLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_database_url"
+}
+
+func (f *ParseDatabaseURLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parses a database URL",
+		Description:         "Parses a libSQL, HTTP or WebSocket database URL, such as a connection string, into its hostname and auth token, and returns the equivalent libSQL and HTTP URLs without the token. URLs with the http or ws scheme are treated as connections without TLS, such as to a local server.",
+		MarkdownDescription: "Parses a libSQL, HTTP or WebSocket database URL, such as a connection string, into its `hostname` and `auth_token`, and returns the equivalent `libsql_url` and `http_url` without the token. URLs with the `http` or `ws` scheme are treated as connections without TLS, such as to a local server.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "url",
+				Description: "The database URL to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"scheme":     types.StringType,
+				"hostname":   types.StringType,
+				"auth_token": types.StringType,
+				"libsql_url": types.StringType,
+				"http_url":   types.StringType,
+			},
+		},
+	}
+}
+
+func (f *ParseDatabaseURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var raw string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &raw))
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := parseDatabaseURL(raw)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, parsed))
+}
+
+func parseDatabaseURL(raw string) (parsedDatabaseURL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return parsedDatabaseURL{}, fmt.Errorf("invalid database URL: %s", err)
+	}
+	var tls bool
+	switch u.Scheme {
+	case "libsql", "https", "wss":
+		tls = true
+	case "http", "ws":
+	default:
+		return parsedDatabaseURL{}, fmt.Errorf(`invalid database URL %q: scheme must be "libsql", "https", "http", "wss" or "ws"`, raw)
+	}
+	if u.Hostname() == "" {
+		return parsedDatabaseURL{}, fmt.Errorf("invalid database URL %q: missing hostname", raw)
+	}
+
+	parsed := parsedDatabaseURL{
+		Scheme:    types.StringValue(u.Scheme),
+		Hostname:  types.StringValue(u.Hostname()),
+		AuthToken: types.StringNull(),
+		LibsqlURL: types.StringValue(libsqlURL(u.Host)),
+		HTTPURL:   types.StringValue(httpURL(u.Host)),
+	}
+	if token := u.Query().Get("authToken"); token != "" {
+		parsed.AuthToken = types.StringValue(token)
+	}
+	if !tls {
+		parsed.LibsqlURL = types.StringValue(libsqlURL(u.Host) + "?tls=0")
+		parsed.HTTPURL = types.StringValue("http://" + u.Host)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseDatabaseURLFunction(t *testing.T) {
+	tests := []struct {
+		url  string
+		want map[string]string
+		err  string
+	}{
+		{
+			url: "libsql://app-acme.turso.io?authToken=secret",
+			want: map[string]string{
+				"scheme":     "libsql",
+				"hostname":   "app-acme.turso.io",
+				"auth_token": "secret",
+				"libsql_url": "libsql://app-acme.turso.io",
+				"http_url":   "https://app-acme.turso.io",
+			},
+		},
+		{
+			url: "https://app-acme.turso.io/",
+			want: map[string]string{
+				"scheme":     "https",
+				"hostname":   "app-acme.turso.io",
+				"libsql_url": "libsql://app-acme.turso.io",
+				"http_url":   "https://app-acme.turso.io",
+			},
+		},
+		{
+			url: "http://127.0.0.1:8080",
+			want: map[string]string{
+				"scheme":     "http",
+				"hostname":   "127.0.0.1",
+				"libsql_url": "libsql://127.0.0.1:8080?tls=0",
+				"http_url":   "http://127.0.0.1:8080",
+			},
+		},
+		{url: "postgres://app-acme.turso.io", err: `scheme must be "libsql", "https", "http", "wss" or "ws"`},
+		{url: "libsql://", err: "missing hostname"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			got, err := runFunction(t, NewParseDatabaseURLFunction(), types.StringValue(tt.url))
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Text, tt.err) {
+					t.Errorf("expected error containing %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			attributes := got.(types.Object).Attributes()
+			for name, value := range attributes {
+				want, ok := tt.want[name]
+				if !ok {
+					if !value.IsNull() {
+						t.Errorf("expected %s to be null, got %s", name, value)
+					}
+					continue
+				}
+				if !value.Equal(types.StringValue(want)) {
+					t.Errorf("expected %s %q, got %s", name, want, value)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ParseSizeFunction{}
+
+func NewParseSizeFunction() function.Function {
+	return &ParseSizeFunction{}
+}
+
+// ParseSizeFunction returns the number of bytes in a size.
+type ParseSizeFunction struct{}
+
+func (f *ParseSizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_size"
+}
+
+func (f *ParseSizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Returns the number of bytes in a size",
+		Description:         "Returns the number of bytes in a size, such as the size_limit of a database. Sizes are a number of bytes, optionally followed by a case-insensitive unit: kb, mb, gb and tb are powers of 1000, and kib, mib, gib and tib are powers of 1024.",
+		MarkdownDescription: "Returns the number of bytes in a size, such as the `size_limit` of a database. Sizes are a number of bytes, optionally followed by a case-insensitive unit: `kb`, `mb`, `gb` and `tb` are powers of 1000, and `kib`, `mib`, `gib` and `tib` are powers of 1024.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "size",
+				Description: "The size to parse, e.g. 256mb.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *ParseSizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var size string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &size))
+	if resp.Error != nil {
+		return
+	}
+
+	bytes, err := parseSize(size)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, bytes))
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseSizeFunction(t *testing.T) {
+	tests := []struct {
+		size string
+		want int64
+		err  string
+	}{
+		{size: "1024", want: 1024},
+		{size: "256mb", want: 256_000_000},
+		{size: "1GB", want: 1_000_000_000},
+		{size: "1.5 gb", want: 1_500_000_000},
+		{size: "1.1mb", want: 1_100_000},
+		{size: "2gib", want: 2 << 30},
+		{size: "512k", want: 512_000},
+		{size: "", err: `invalid size "": expected a number of bytes`},
+		{size: "1pb", err: `invalid size "1pb": unknown unit "pb"`},
+		{size: "1.5b", err: `invalid size "1.5b": not a whole number of bytes`},
+		{size: "1..5mb", err: `invalid size "1..5mb": invalid number "1..5"`},
+		{size: "10000000tb", err: `invalid size "10000000tb": too large`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.size, func(t *testing.T) {
+			got, err := runFunction(t, NewParseSizeFunction(), types.StringValue(tt.size))
+			if tt.err != "" {
+				if err == nil || !strings.HasPrefix(err.Text, tt.err) {
+					t.Errorf("expected error %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(types.Int64Value(tt.want)) {
+				t.Errorf("expected %d, got %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -2,12 +2,29 @@ package provider
 
 import (
 	"cmp"
+	"context"
+	"fmt"
 	"log"
+	"regexp"
 	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func optString(s basetypes.StringValue) tursoclient.OptString {
@@ -37,6 +54,19 @@ func decodeStringSet(v basetypes.SetValue) []string {
 	return result
 }
 
+func decodeStringList(v basetypes.ListValue) []string {
+	elements := v.Elements()
+	result := make([]string, len(elements))
+	for i, e := range elements {
+		v, ok := e.(basetypes.StringValue)
+		if !ok {
+			log.Panicf("unexpected type in string list: %T", e)
+		}
+		result[i] = v.ValueString()
+	}
+	return result
+}
+
 func encodeStringSet(v []string) basetypes.SetValue {
 	elements := make([]attr.Value, len(v))
 	for i, s := range v {
@@ -53,6 +83,14 @@ func encodeStringList(v []string) basetypes.ListValue {
 	return basetypes.NewListValueMust(basetypes.StringType{}, elements)
 }
 
+func encodeStringMap(v map[string]string) basetypes.MapValue {
+	elements := make(map[string]attr.Value, len(v))
+	for k, s := range v {
+		elements[k] = basetypes.NewStringValue(s)
+	}
+	return basetypes.NewMapValueMust(basetypes.StringType{}, elements)
+}
+
 func mergeLists[S ~[]E, E cmp.Ordered](a S, b S) S {
 	set := make(map[E]struct{})
 	for _, v := range a {
@@ -72,3 +110,160 @@ func mergeLists[S ~[]E, E cmp.Ordered](a S, b S) S {
 func isProvided(v attr.Value) bool {
 	return !v.IsNull() && !v.IsUnknown()
 }
+
+// useStateForUnknown adds the UseStateForUnknown plan modifier to the named
+// attributes of a nested object, whose values never change once created.
+func useStateForUnknown(attributes map[string]schema.Attribute, names ...string) error {
+	for _, name := range names {
+		switch a := attributes[name].(type) {
+		case schema.BoolAttribute:
+			a.PlanModifiers = append(a.PlanModifiers, boolplanmodifier.UseStateForUnknown())
+			attributes[name] = a
+		case schema.ListAttribute:
+			a.PlanModifiers = append(a.PlanModifiers, listplanmodifier.UseStateForUnknown())
+			attributes[name] = a
+		case schema.SetAttribute:
+			a.PlanModifiers = append(a.PlanModifiers, setplanmodifier.UseStateForUnknown())
+			attributes[name] = a
+		case schema.StringAttribute:
+			a.PlanModifiers = append(a.PlanModifiers, stringplanmodifier.UseStateForUnknown())
+			attributes[name] = a
+		default:
+			return fmt.Errorf("unsupported attribute %s of type %T", name, a)
+		}
+	}
+	return nil
+}
+
+// unknownAttributesModifier plans a computed object of an existing resource
+// as a known object with unknown attributes instead of an unknown object. The
+// framework skips the plan modifiers of the attributes of unknown objects, so
+// this lets modifiers such as UseStateForUnknown keep the values which do not
+// change.
+type unknownAttributesModifier struct{}
+
+var _ planmodifier.Object = unknownAttributesModifier{}
+
+func (m unknownAttributesModifier) Description(ctx context.Context) string {
+	return "Plans the attributes of the object individually once the resource exists."
+}
+
+func (m unknownAttributesModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m unknownAttributesModifier) PlanModifyObject(ctx context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	attrTypes := req.PlanValue.AttributeTypes(ctx)
+	attributes := make(map[string]attr.Value, len(attrTypes))
+	for name, t := range attrTypes {
+		v, err := t.ValueFromTerraform(ctx, tftypes.NewValue(t.TerraformType(ctx), tftypes.UnknownValue))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path, "Failed to plan object", err.Error())
+			return
+		}
+		attributes[name] = v
+	}
+	planValue, diags := basetypes.NewObjectValue(attrTypes, attributes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.PlanValue = planValue
+}
+
+// maxNameLength is the longest name the Turso API accepts for a database or
+// group.
+const maxNameLength = 64
+
+// nameValidators returns the validators for the name of a database or group,
+// which the Turso API limits to 64 lowercase letters, numbers and dashes.
+func nameValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthBetween(1, maxNameLength),
+		stringvalidator.RegexMatches(
+			regexp.MustCompile(`^[a-z0-9-]+$`),
+			"must contain only lowercase letters, numbers and dashes",
+		),
+	}
+}
+
+// forEachParallel calls fn for every item, with at most parallelism calls in
+// flight at once. It waits for all calls to finish and returns the error of
+// each call, indexed like items. Once ctx is done no more calls are started,
+// and the error of each item that was not called is ctx.Err().
+func forEachParallel[T any](ctx context.Context, parallelism int, items []T, fn func(context.Context, T) error) []error {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	errs := make([]error, len(items))
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i, item := range items {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if err := ctx.Err(); err != nil {
+			for j := i; j < len(items); j++ {
+				errs[j] = err
+			}
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			errs[i] = fn(ctx, item)
+		}()
+	}
+	wg.Wait()
+	return errs
+}
+
+// resourceTimeoutsBlock returns the timeouts block shared by all resources.
+// Each timeout bounds the whole operation, including any retries and waiting.
+func resourceTimeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create:            true,
+		Read:              true,
+		Update:            true,
+		Delete:            true,
+		CreateDescription: timeoutDescription("creating", defaultCreateTimeout),
+		ReadDescription:   timeoutDescription("reading", defaultReadTimeout),
+		UpdateDescription: timeoutDescription("updating", defaultUpdateTimeout),
+		DeleteDescription: timeoutDescription("deleting", defaultDeleteTimeout),
+	})
+}
+
+func timeoutDescription(operation string, defaultTimeout time.Duration) string {
+	return fmt.Sprintf(
+		"The time allowed for %s the resource, including any waiting, as a [duration](https://pkg.go.dev/time#ParseDuration) such as `30s` or `2h45m`. Defaults to `%s`.",
+		operation, formatDuration(defaultTimeout),
+	)
+}
+
+// formatDuration formats d without zero units, e.g. 20m rather than 20m0s.
+func formatDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
+
+// sendProgress reports the progress of an action to Terraform, which shows the
+// message while the action runs.
+func sendProgress(resp *action.InvokeResponse, format string, args ...any) {
+	message := fmt.Sprintf(format, args...)
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestForEachParallel(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	errFailed := errors.New("failed")
+
+	var inFlight, maxInFlight atomic.Int32
+	errs := forEachParallel(context.Background(), 3, items, func(ctx context.Context, item int) error {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		if item%4 == 0 {
+			return errFailed
+		}
+		return nil
+	})
+
+	if got := maxInFlight.Load(); got != 3 {
+		t.Errorf("expected at most 3 calls in flight, got %d", got)
+	}
+	for i, err := range errs {
+		if want := i%4 == 0; (err != nil) != want {
+			t.Errorf("item %d: unexpected error %v", i, err)
+		}
+	}
+}
+
+func TestNameValidators(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid bool
+	}{
+		{name: "db-1", valid: true},
+		{name: strings.Repeat("a", 64), valid: true},
+		{name: strings.Repeat("a", 65)},
+		{name: ""},
+		{name: "Db"},
+		{name: "db_1"},
+		{name: "db.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("name"), ConfigValue: types.StringValue(tt.name)}
+			var resp validator.StringResponse
+			for _, v := range nameValidators() {
+				v.ValidateString(context.Background(), req, &resp)
+			}
+			if valid := !resp.Diagnostics.HasError(); valid != tt.valid {
+				t.Errorf("expected valid %v, got %v", tt.valid, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestUnknownAttributesModifier(t *testing.T) {
+	ctx := context.Background()
+	attrTypes := map[string]attr.Type{"id": types.StringType}
+	state := types.ObjectValueMust(attrTypes, map[string]attr.Value{"id": types.StringValue("a")})
+
+	req := planmodifier.ObjectRequest{
+		ConfigValue: types.ObjectNull(attrTypes),
+		PlanValue:   types.ObjectUnknown(attrTypes),
+		StateValue:  state,
+	}
+	resp := planmodifier.ObjectResponse{PlanValue: req.PlanValue}
+	unknownAttributesModifier{}.PlanModifyObject(ctx, req, &resp)
+	want := types.ObjectValueMust(attrTypes, map[string]attr.Value{"id": types.StringUnknown()})
+	if !resp.PlanValue.Equal(want) {
+		t.Errorf("expected known object with unknown attributes, got %v", resp.PlanValue)
+	}
+
+	// New resources keep the unknown object.
+	req.StateValue = types.ObjectNull(attrTypes)
+	resp = planmodifier.ObjectResponse{PlanValue: req.PlanValue}
+	unknownAttributesModifier{}.PlanModifyObject(ctx, req, &resp)
+	if !resp.PlanValue.IsUnknown() {
+		t.Errorf("expected unknown object, got %v", resp.PlanValue)
+	}
+}
+
+func TestForEachParallel_Cancelled(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls atomic.Int32
+	errs := forEachParallel(ctx, 2, items, func(ctx context.Context, item int) error {
+		calls.Add(1)
+		if item == 1 {
+			cancel()
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected no calls to start after cancellation, got %d calls", got)
+	}
+	for i, err := range errs {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("item %d: expected context.Canceled, got %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceIdentityModel is the identity of a group or database. Names are
+// unique within an organization, so the two identify it across Turso.
+type resourceIdentityModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Name         types.String `tfsdk:"name"`
+}
+
+// resourceIdentitySchema returns the identity schema of a resource of the given
+// kind, e.g. "database".
+func resourceIdentitySchema(kind string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization the " + kind + " belongs to. Defaults to the organization the provider is configured for.",
+				OptionalForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "The name of the " + kind + ".",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// setIdentity sets the identity of the resource called name in the configured
+// organization. The identity is nil when Terraform does not support resource
+// identities.
+func (r *tursoProviderConfig) setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, name string) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, resourceIdentityModel{
+		Organization: types.StringValue(r.Organization),
+		Name:         types.StringValue(name),
+	})
+}
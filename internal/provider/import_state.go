@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// importedKey is the private state key marking a resource which was imported
+// and has not been updated since. Settings which only apply when creating a
+// resource cannot be read back from the API, so they are taken from the
+// configuration by the first update.
+const importedKey = "imported"
+
+// parseImportID returns the name in an import ID of the form name or
+// organization/name. The organization must be the one the provider is
+// configured for.
+func parseImportID(id, organization string) (string, error) {
+	name := id
+	if org, rest, ok := strings.Cut(id, "/"); ok {
+		if org != organization {
+			return "", fmt.Errorf("the import ID is for organization %q, but the provider is configured for organization %q", org, organization)
+		}
+		name = rest
+	}
+	if name == "" || strings.Contains(name, "/") {
+		return "", fmt.Errorf("expected an import ID of the form name or organization/name, got %q", id)
+	}
+	return name, nil
+}
+
+// importName returns the name in the import ID, or an error diagnostic if it is
+// invalid. See parseImportID.
+func (r *tursoProviderConfig) importName(id string) (string, diag.Diagnostics) {
+	var organization string
+	if r != nil {
+		organization = r.Organization
+	}
+	name, err := parseImportID(id, organization)
+	if err != nil {
+		return "", diag.Diagnostics{
+			diag.NewErrorDiagnostic("Invalid import ID", err.Error()),
+		}
+	}
+	return name, nil
+}
+
+// importResourceName returns the name of the group or database being imported,
+// either by an import ID (see importName) or by its identity.
+func (r *tursoProviderConfig) importResourceName(ctx context.Context, req resource.ImportStateRequest) (string, diag.Diagnostics) {
+	if req.ID != "" || req.Identity == nil {
+		return r.importName(req.ID)
+	}
+	var identity resourceIdentityModel
+	diags := req.Identity.Get(ctx, &identity)
+	if diags.HasError() {
+		return "", diags
+	}
+	diags.Append(r.checkImportOrganization(identity.Organization)...)
+	if identity.Name.ValueString() == "" {
+		diags.AddError("Invalid import identity", "The name of the resource to import must be set.")
+	}
+	return identity.Name.ValueString(), diags
+}
+
+// checkImportOrganization returns an error if the organization in the identity
+// of a resource being imported is set and is not the one the provider is
+// configured for.
+func (r *tursoProviderConfig) checkImportOrganization(organization types.String) diag.Diagnostics {
+	var configured string
+	if r != nil {
+		configured = r.Organization
+	}
+	if organization.IsNull() || organization.ValueString() == configured {
+		return nil
+	}
+	return diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Invalid import identity",
+			fmt.Sprintf("The import identity is for organization %q, but the provider is configured for organization %q.", organization.ValueString(), configured),
+		),
+	}
+}
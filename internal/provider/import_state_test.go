@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestParseImportID(t *testing.T) {
+	tests := []struct {
+		id      string
+		want    string
+		wantErr bool
+	}{
+		{id: "db", want: "db"},
+		{id: "test-org/db", want: "db"},
+		{id: "other-org/db", wantErr: true},
+		{id: "test-org/", wantErr: true},
+		{id: "test-org/db/extra", wantErr: true},
+		{id: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			got, err := parseImportID(tt.id, "test-org")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestImportResourceName(t *testing.T) {
+	ctx := context.Background()
+	config := &tursoProviderConfig{Organization: "test-org"}
+
+	identity := func(organization, name types.String) *tfsdk.ResourceIdentity {
+		s := resourceIdentitySchema("database")
+		identity := &tfsdk.ResourceIdentity{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+		if diags := identity.Set(ctx, resourceIdentityModel{Organization: organization, Name: name}); diags.HasError() {
+			t.Fatalf("error encoding identity: %v", diags)
+		}
+		return identity
+	}
+	tests := []struct {
+		name    string
+		req     fwresource.ImportStateRequest
+		want    string
+		wantErr bool
+	}{
+		{name: "id", req: fwresource.ImportStateRequest{ID: "test-org/db"}, want: "db"},
+		{name: "identity", req: fwresource.ImportStateRequest{Identity: identity(types.StringNull(), types.StringValue("db"))}, want: "db"},
+		{name: "identity with organization", req: fwresource.ImportStateRequest{Identity: identity(types.StringValue("test-org"), types.StringValue("db"))}, want: "db"},
+		{name: "identity for other organization", req: fwresource.ImportStateRequest{Identity: identity(types.StringValue("other-org"), types.StringValue("db"))}, wantErr: true},
+		{name: "identity without name", req: fwresource.ImportStateRequest{Identity: identity(types.StringNull(), types.StringValue(""))}, wantErr: true},
+		{name: "neither", req: fwresource.ImportStateRequest{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := config.importResourceName(ctx, tt.req)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, diags)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+// newPrivateState returns empty private state data of the type of p, which
+// the framework only allocates when serving requests from Terraform.
+func newPrivateState[T any](p *T) *T {
+	return new(T)
+}
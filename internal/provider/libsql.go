@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/tursodatabase/libsql-client-go/libsql"
+)
+
+// databaseHostname returns the hostname Turso assigns to a database in an
+// organization.
+func databaseHostname(name, organization string) string {
+	return name + "-" + organization + ".turso.io"
+}
+
+// libsqlURL returns the URL used by libSQL clients to connect to a database
+// hostname.
+func libsqlURL(hostname string) string {
+	return "libsql://" + hostname
+}
+
+// httpURL returns the URL of the libSQL HTTP API served by a database
+// hostname.
+func httpURL(hostname string) string {
+	return "https://" + hostname
+}
+
+// connectionString returns the libSQL URL of a database hostname with the
+// token embedded as the authToken query parameter, as accepted by libSQL
+// clients.
+func connectionString(hostname, token string) string {
+	return libsqlURL(hostname) + "?" + url.Values{"authToken": {token}}.Encode()
+}
+
+// databaseEndpoint is the libSQL HTTP API of a database, with a token to
+// access it.
+type databaseEndpoint struct {
+	url   string
+	token string
+}
+
+// open returns a handle to the database, which must be closed by the caller.
+func (e databaseEndpoint) open() (*sql.DB, error) {
+	connector, err := libsql.NewConnector(e.url, libsql.WithAuthToken(e.token))
+	if err != nil {
+		return nil, err
+	}
+	return sql.OpenDB(connector), nil
+}
+
+// dump writes the contents of the database to w as a script of SQL
+// statements, as served by the dump endpoint of libSQL server. The endpoint is
+// not part of the Hrana protocol, so libsql-client-go does not support it.
+func (e databaseEndpoint) dump(ctx context.Context, w io.Writer) error {
+	dumpURL, err := url.JoinPath(e.url, "dump")
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, dumpURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+e.token)
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("%s: %s", res.Status, strings.TrimSpace(string(body)))
+	}
+	if _, err := io.Copy(w, res.Body); err != nil {
+		return fmt.Errorf("reading dump: %w", err)
+	}
+	return nil
+}
+
+// openDatabase opens the database over its libSQL HTTP API, with a token which
+// is valid for at least ttl, see databaseEndpoint. If instance is set, the database is
+// opened at the hostname of that instance. The database must be closed by the
+// caller.
+func (r *tursoProviderConfig) openDatabase(ctx context.Context, name, instance string, authorization tursoclient.CreateDatabaseTokenAuthorization, ttl time.Duration) (*sql.DB, diag.Diagnostics) {
+	endpoint, diags := r.databaseEndpoint(ctx, name, instance, authorization, ttl)
+	if diags.HasError() {
+		return nil, diags
+	}
+	db, err := endpoint.open()
+	if err != nil {
+		return nil, diag.Diagnostics{
+			diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Unable to open database, got error: %s", err)),
+		}
+	}
+	return db, nil
+}
+
+// tokenReuseWindow is how much longer than requested the tokens minted by
+// databaseEndpoint are valid for, so that other requests of the same
+// operation can reuse them rather than minting a token each.
+const tokenReuseWindow = 5 * time.Minute
+
+// databaseTokens caches the tokens minted by databaseEndpoint for the
+// lifetime of the provider, which is a single Terraform operation. The zero
+// value is an empty cache.
+type databaseTokens struct {
+	mu     sync.Mutex
+	tokens map[databaseTokenKey]databaseToken
+}
+
+// databaseTokenKey identifies the tokens which can be reused. Tokens are keyed
+// by the ID of the database rather than its name, since a database which is
+// recreated under the same name does not accept the tokens of the original.
+type databaseTokenKey struct {
+	databaseID    string
+	authorization tursoclient.CreateDatabaseTokenAuthorization
+}
+
+type databaseToken struct {
+	jwt     string
+	expires time.Time
+}
+
+// get returns a cached token which is valid until at least validUntil.
+func (c *databaseTokens) get(key databaseTokenKey, validUntil time.Time) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	token, ok := c.tokens[key]
+	if !ok || !token.expires.After(validUntil) {
+		return "", false
+	}
+	return token.jwt, true
+}
+
+func (c *databaseTokens) put(key databaseTokenKey, token databaseToken) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.tokens == nil {
+		c.tokens = make(map[databaseTokenKey]databaseToken)
+	}
+	c.tokens[key] = token
+}
+
+// forget empties the cache, once tokens were invalidated.
+func (c *databaseTokens) forget() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tokens = nil
+}
+
+// databaseEndpoint returns the endpoint of the libSQL HTTP API of the
+// database, with a token which is valid for at least ttl. The token is reused
+// from an earlier call if possible, and minted otherwise. If instance is set,
+// the endpoint is at the hostname of that instance of the database.
+func (r *tursoProviderConfig) databaseEndpoint(ctx context.Context, name, instance string, authorization tursoclient.CreateDatabaseTokenAuthorization, ttl time.Duration) (databaseEndpoint, diag.Diagnostics) {
+	db, diags := r.readDatabase(ctx, name)
+	if diags.HasError() {
+		return databaseEndpoint{}, diags
+	}
+	hostname := db.Hostname.Value
+	if instance != "" {
+		hostname, diags = r.instanceHostname(ctx, name, instance)
+		if diags.HasError() {
+			return databaseEndpoint{}, diags
+		}
+	}
+	databaseURL := r.DatabaseURL
+	if databaseURL == nil {
+		databaseURL = httpURL
+	}
+
+	key := databaseTokenKey{databaseID: db.DbId.Value, authorization: authorization}
+	now := time.Now()
+	if jwt, ok := r.tokens.get(key, now.Add(ttl)); ok {
+		return databaseEndpoint{url: databaseURL(hostname), token: jwt}, nil
+	}
+
+	// Tokens expire in whole minutes.
+	minutes := int(math.Ceil((ttl + tokenReuseWindow).Minutes()))
+	res, err := r.Client.CreateDatabaseToken(ctx, tursoclient.OptCreateTokenInput{}, tursoclient.CreateDatabaseTokenParams{
+		OrganizationName: r.Organization,
+		DatabaseName:     name,
+		Expiration:       tursoclient.NewOptString(fmt.Sprintf("%dm", minutes)),
+		Authorization:    tursoclient.NewOptCreateDatabaseTokenAuthorization(authorization),
+	})
+	if err != nil {
+		return databaseEndpoint{}, diag.Diagnostics{
+			diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Unable to create database token, got error: %s", err)),
+		}
+	}
+	token, ok := res.(*tursoclient.CreateDatabaseTokenOK)
+	if !ok {
+		return databaseEndpoint{}, diag.Diagnostics{
+			diag.NewErrorDiagnostic("Client Error", "Unable to create database token, token not returned from server"),
+		}
+	}
+	r.tokens.put(key, databaseToken{jwt: token.Jwt.Value, expires: now.Add(time.Duration(minutes) * time.Minute)})
+	return databaseEndpoint{url: databaseURL(hostname), token: token.Jwt.Value}, nil
+}
+
+// instanceHostname returns the hostname of the named instance of the database.
+func (r *tursoProviderConfig) instanceHostname(ctx context.Context, name, instance string) (string, diag.Diagnostics) {
+	res, err := r.Client.ListDatabaseInstances(ctx, tursoclient.ListDatabaseInstancesParams{
+		OrganizationName: r.Organization,
+		DatabaseName:     name,
+	})
+	if err != nil {
+		return "", diag.Diagnostics{
+			diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Unable to list database instances, got error: %s", err)),
+		}
+	}
+	names := make([]string, 0, len(res.Instances))
+	for _, i := range res.Instances {
+		if i.Name.Value == instance {
+			return i.Hostname.Value, nil
+		}
+		names = append(names, i.Name.Value)
+	}
+	return "", diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(path.Root("instance"), "Instance not found", fmt.Sprintf("Database %s has no instance %q. Its instances are: %s.", name, instance, strings.Join(names, ", "))),
+	}
+}
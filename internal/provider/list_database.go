@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/resource_database"
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResource = &DatabaseListResource{}
+var _ list.ListResourceWithConfigure = &DatabaseListResource{}
+
+func NewDatabaseListResource() list.ListResource {
+	return &DatabaseListResource{}
+}
+
+// DatabaseListResource lists the databases of the organization for terraform
+// query, so that they can be imported.
+type DatabaseListResource struct {
+	*tursoProviderConfig
+}
+
+// databaseListModel is the configuration of a turso_database list block.
+type databaseListModel struct {
+	Group  types.String `tfsdk:"group"`
+	Schema types.String `tfsdk:"schema"`
+}
+
+func (r *DatabaseListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database"
+}
+
+func (r *DatabaseListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the databases of the organization.",
+		Attributes: map[string]schema.Attribute{
+			"group": schema.StringAttribute{
+				MarkdownDescription: "Only list the databases in this group.",
+				Optional:            true,
+			},
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "Only list the databases using this schema database.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *DatabaseListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tursoProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *tursoProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.tursoProviderConfig = client
+}
+
+// List lists the databases matching the configured filters. When Terraform
+// asks for the resources as well, each one is read as it would be after being
+// imported.
+func (r *DatabaseListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data databaseListModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	res, err := r.Client.ListDatabases(ctx, tursoclient.ListDatabasesParams{
+		OrganizationName: r.Organization,
+		Group:            optString(data.Group),
+		Schema:           optString(data.Schema),
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list databases, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, db := range res.Databases {
+			// The schema database is not one of its children, should the
+			// API include it.
+			if isProvided(data.Schema) && db.Name.Value == data.Schema.ValueString() {
+				continue
+			}
+			result := req.NewListResult(ctx)
+			result.DisplayName = db.Name.Value
+			result.Diagnostics.Append(r.setIdentity(ctx, result.Identity, db.Name.Value)...)
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				result.Diagnostics.Append(r.listedDatabase(ctx, db, result.Resource)...)
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// listedDatabase sets resource to the state of the database after importing
+// it.
+func (r *DatabaseListResource) listedDatabase(ctx context.Context, db tursoclient.Database, resource *tfsdk.Resource) diag.Diagnostics {
+	diags := resource.SetAttribute(ctx, path.Root("name"), db.Name.Value)
+	diags.Append(resource.SetAttribute(ctx, path.Root("deletion_protection"), r.tursoProviderConfig.deletionProtection())...)
+	var data resource_database.DatabaseModel
+	diags.Append(resource.Get(ctx, &data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	databaseResource := &DatabaseResource{tursoProviderConfig: r.tursoProviderConfig}
+	diags.Append(databaseResource.setDatabaseResource(ctx, db, &data)...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(databaseResource.readImportedDatabaseSettings(ctx, &data)...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(resource.Set(ctx, &data)...)
+	return diags
+}
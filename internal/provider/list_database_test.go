@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/resource_database"
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// listAll lists the resources of r for the config, a model of its list block,
+// and returns the results. It fails the test if any result has an error.
+func listAll(t *testing.T, ctx context.Context, r list.ListResource, resourceSchema schema.Schema, config any, includeResource bool) []list.ListResult {
+	t.Helper()
+
+	var schemaResp list.ListResourceSchemaResponse
+	r.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResp)
+	configState := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := configState.Set(ctx, config); diags.HasError() {
+		t.Fatalf("error encoding config: %v", diags)
+	}
+
+	req := list.ListRequest{
+		Config:                 tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw},
+		IncludeResource:        includeResource,
+		ResourceSchema:         resourceSchema,
+		ResourceIdentitySchema: resourceIdentitySchema("resource"),
+	}
+	var stream list.ListResultsStream
+	r.List(ctx, req, &stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("error listing resources: %v", result.Diagnostics)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// listedIdentities returns the identities of the results.
+func listedIdentities(t *testing.T, ctx context.Context, results []list.ListResult) []resourceIdentityModel {
+	t.Helper()
+
+	identities := make([]resourceIdentityModel, len(results))
+	for i, result := range results {
+		if diags := result.Identity.Get(ctx, &identities[i]); diags.HasError() {
+			t.Fatalf("error decoding identity: %v", diags)
+		}
+	}
+	return identities
+}
+
+func TestDatabaseListResourceList(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	config := fake.start(t)
+	groups := &GroupResource{tursoProviderConfig: config}
+	createGroup(t, ctx, groups, "test", "sjc", "sjc")
+	createGroup(t, ctx, groups, "other", "ams", "ams")
+	fake.addDatabase("app", "test", "app-test-org.turso.io")
+	fake.addDatabase("archive", "other", "archive-test-org.turso.io")
+	fake.addDatabase("parent", "test", "parent-test-org.turso.io").IsSchema = tursoclient.NewOptBool(true)
+	fake.addDatabase("child", "test", "child-test-org.turso.io").Schema = tursoclient.NewOptNilString("parent")
+
+	r := &DatabaseListResource{tursoProviderConfig: config}
+	s := testDatabaseSchema(t, ctx, &DatabaseResource{tursoProviderConfig: config})
+
+	tests := []struct {
+		name   string
+		config databaseListModel
+		want   []string
+	}{
+		{
+			name:   "all",
+			config: databaseListModel{Group: types.StringNull(), Schema: types.StringNull()},
+			want:   []string{"app", "archive", "child", "parent"},
+		},
+		{
+			name:   "group",
+			config: databaseListModel{Group: types.StringValue("other"), Schema: types.StringNull()},
+			want:   []string{"archive"},
+		},
+		{
+			name:   "schema",
+			config: databaseListModel{Group: types.StringNull(), Schema: types.StringValue("parent")},
+			want:   []string{"child"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := listAll(t, ctx, r, s, tt.config, false)
+			var names []string
+			for i, identity := range listedIdentities(t, ctx, results) {
+				if identity.Organization.ValueString() != "test-org" {
+					t.Errorf("expected organization test-org, got %s", identity.Organization)
+				}
+				if results[i].DisplayName != identity.Name.ValueString() {
+					t.Errorf("expected display name %s, got %q", identity.Name, results[i].DisplayName)
+				}
+				if !results[i].Resource.Raw.IsNull() {
+					t.Errorf("expected no resource for %s when it was not requested", identity.Name)
+				}
+				names = append(names, identity.Name.ValueString())
+			}
+			if !slices.Equal(names, tt.want) {
+				t.Errorf("expected databases %v, got %v", tt.want, names)
+			}
+		})
+	}
+}
+
+func TestDatabaseListResourceList_IncludesResource(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	config := fake.start(t)
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: config}, "test", "sjc", "sjc")
+	fake.addDatabase("app", "test", "app-test-org.turso.io").BlockWrites = tursoclient.NewOptBool(true)
+
+	r := &DatabaseListResource{tursoProviderConfig: config}
+	dr := &DatabaseResource{tursoProviderConfig: config}
+	s := testDatabaseSchema(t, ctx, dr)
+	results := listAll(t, ctx, r, s, databaseListModel{Group: types.StringNull(), Schema: types.StringNull()}, true)
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+
+	var listed resource_database.DatabaseModel
+	if diags := results[0].Resource.Get(ctx, &listed); diags.HasError() {
+		t.Fatalf("error decoding listed resource: %v", diags)
+	}
+
+	// The listed resource is the state an import of the database produces.
+	importResp := fwresource.ImportStateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	importResp.Private = newPrivateState(importResp.Private)
+	dr.ImportState(ctx, fwresource.ImportStateRequest{ID: "app"}, &importResp)
+	readResp := fwresource.ReadResponse{State: importResp.State}
+	dr.Read(ctx, fwresource.ReadRequest{State: importResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("error reading imported database: %v", readResp.Diagnostics)
+	}
+	if !results[0].Resource.Raw.Equal(readResp.State.Raw) {
+		t.Errorf("expected the listed resource to match the imported one:\n%s\ngot:\n%s", readResp.State.Raw, results[0].Resource.Raw)
+	}
+	if !listed.BlockWrites.ValueBool() || listed.Group.ValueString() != "test" {
+		t.Errorf("expected the listed resource to have the database settings, got %+v", listed)
+	}
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/resource_group"
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ list.ListResource = &GroupListResource{}
+var _ list.ListResourceWithConfigure = &GroupListResource{}
+
+func NewGroupListResource() list.ListResource {
+	return &GroupListResource{}
+}
+
+// GroupListResource lists the groups of the organization for terraform query,
+// so that they can be imported.
+type GroupListResource struct {
+	*tursoProviderConfig
+}
+
+func (r *GroupListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (r *GroupListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the groups of the organization.",
+	}
+}
+
+func (r *GroupListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tursoProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *tursoProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.tursoProviderConfig = client
+}
+
+// List lists the groups of the organization. When Terraform asks for the
+// resources as well, each one is read as it would be after being imported.
+func (r *GroupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	res, err := r.Client.ListGroups(ctx, tursoclient.ListGroupsParams{
+		OrganizationName: r.Organization,
+	})
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Unable to list groups, got error: %s", err)),
+		})
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, group := range res.Groups {
+			result := req.NewListResult(ctx)
+			result.DisplayName = group.Name.Value
+			result.Diagnostics.Append(r.setIdentity(ctx, result.Identity, group.Name.Value)...)
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				result.Diagnostics.Append(r.listedGroup(ctx, group, result.Resource)...)
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// listedGroup sets resource to the state of the group after importing it.
+func (r *GroupListResource) listedGroup(ctx context.Context, group tursoclient.BaseGroup, resource *tfsdk.Resource) diag.Diagnostics {
+	diags := resource.SetAttribute(ctx, path.Root("name"), group.Name.Value)
+	diags.Append(resource.SetAttribute(ctx, path.Root("deletion_protection"), r.tursoProviderConfig.deletionProtection())...)
+	var data resource_group.GroupModel
+	diags.Append(resource.Get(ctx, &data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(setGroupResource(ctx, group, &data)...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(resource.Set(ctx, &data)...)
+	return diags
+}
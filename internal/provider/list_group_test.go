@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestGroupListResourceList(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	config := fake.start(t)
+	gr := &GroupResource{tursoProviderConfig: config}
+	createGroup(t, ctx, gr, "test", "sjc", "sjc", "dfw")
+	createGroup(t, ctx, gr, "other", "ams", "ams")
+
+	r := &GroupListResource{tursoProviderConfig: config}
+	s := testGroupSchema(t, ctx, gr)
+	results := listAll(t, ctx, r, s, struct{}{}, true)
+
+	var names []string
+	for _, identity := range listedIdentities(t, ctx, results) {
+		if identity.Organization.ValueString() != "test-org" {
+			t.Errorf("expected organization test-org, got %s", identity.Organization)
+		}
+		names = append(names, identity.Name.ValueString())
+	}
+	if want := []string{"other", "test"}; !slices.Equal(names, want) {
+		t.Fatalf("expected groups %v, got %v", want, names)
+	}
+
+	// The listed resource is the state an import of the group produces.
+	importResp := fwresource.ImportStateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	gr.ImportState(ctx, fwresource.ImportStateRequest{ID: "test"}, &importResp)
+	readResp := fwresource.ReadResponse{State: importResp.State}
+	gr.Read(ctx, fwresource.ReadRequest{State: importResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("error reading imported group: %v", readResp.Diagnostics)
+	}
+	if !results[1].Resource.Raw.Equal(readResp.State.Raw) {
+		t.Errorf("expected the listed resource to match the imported one:\n%s\ngot:\n%s", readResp.State.Raw, results[1].Resource.Raw)
+	}
+	if got := sortedStrings(decodeStringSet(testGroupState(t, ctx, readResp.State).Locations)); !slices.Equal(got, []string{"dfw", "sjc"}) {
+		t.Errorf("expected the listed group to have locations [dfw sjc], got %v", got)
+	}
+}
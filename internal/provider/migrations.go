@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// migration is a SQL migration file.
+type migration struct {
+	ID       string
+	File     string
+	SQL      string
+	Checksum string
+}
+
+// migrationID returns the ID of the migration file, which is its file name
+// without the extension.
+func migrationID(file string) string {
+	base := filepath.Base(file)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+// loadMigrations reads the migration files in order.
+func loadMigrations(files []string) ([]migration, error) {
+	migrations := make([]migration, 0, len(files))
+	seen := make(map[string]string, len(files))
+	for _, file := range files {
+		id := migrationID(file)
+		if other, ok := seen[id]; ok {
+			return nil, fmt.Errorf("migrations %s and %s have the same ID %q", other, file, id)
+		}
+		seen[id] = file
+
+		contents, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(contents)
+		migrations = append(migrations, migration{
+			ID:       id,
+			File:     file,
+			SQL:      string(contents),
+			Checksum: hex.EncodeToString(sum[:]),
+		})
+	}
+	return migrations, nil
+}
+
+// checkMigrations returns an error if an applied migration, given by ID with
+// its checksum, was changed, or if a migration which has not been applied
+// comes before one which has.
+func checkMigrations(migrations []migration, applied map[string]string) error {
+	pending := ""
+	for _, m := range migrations {
+		checksum, ok := applied[m.ID]
+		if !ok {
+			if pending == "" {
+				pending = m.ID
+			}
+			continue
+		}
+		if checksum != m.Checksum {
+			return fmt.Errorf("migration %s was changed after it was applied", m.ID)
+		}
+		if pending != "" {
+			return fmt.Errorf("migration %s was applied, but the earlier migration %s was not; new migrations must be appended", m.ID, pending)
+		}
+	}
+	return nil
+}
+
+// migrator applies migrations to a database, recording them in a tracking
+// table.
+type migrator struct {
+	db    *sql.DB
+	table string
+}
+
+func (m *migrator) quotedTable() string {
+	return quoteIdentifier(m.table)
+}
+
+// quoteIdentifier quotes the name of a table for use in SQL.
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// applied returns the checksum of each applied migration by ID.
+func (m *migrator) applied(ctx context.Context) (map[string]string, error) {
+	applied := map[string]string{}
+	var name string
+	err := m.db.QueryRowContext(ctx, "SELECT name FROM sqlite_master WHERE type = 'table' AND name = ?", m.table).Scan(&name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return applied, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("checking for tracking table: %w", err)
+	}
+
+	rows, err := m.db.QueryContext(ctx, "SELECT id, checksum FROM "+m.quotedTable())
+	if err != nil {
+		return nil, fmt.Errorf("reading tracking table: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id, checksum string
+		if err := rows.Scan(&id, &checksum); err != nil {
+			return nil, fmt.Errorf("reading tracking table: %w", err)
+		}
+		applied[id] = checksum
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading tracking table: %w", err)
+	}
+	return applied, nil
+}
+
+// apply applies the migrations which have not been applied yet, each in its
+// own transaction. It returns the IDs of the migrations which are applied,
+// including those applied before, which is accurate even if an error is
+// returned.
+func (m *migrator) apply(ctx context.Context, migrations []migration) ([]string, error) {
+	_, err := m.db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+m.quotedTable()+" (id TEXT PRIMARY KEY, checksum TEXT NOT NULL, applied_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP)")
+	if err != nil {
+		return nil, fmt.Errorf("creating tracking table: %w", err)
+	}
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(migrations))
+	for _, migration := range migrations {
+		if _, ok := applied[migration.ID]; ok {
+			ids = append(ids, migration.ID)
+		}
+	}
+	if err := checkMigrations(migrations, applied); err != nil {
+		return ids, err
+	}
+
+	for _, migration := range migrations {
+		if _, ok := applied[migration.ID]; ok {
+			continue
+		}
+		tflog.Debug(ctx, "applying migration", map[string]interface{}{
+			"migration": migration.ID,
+		})
+		if err := m.applyOne(ctx, migration); err != nil {
+			return ids, fmt.Errorf("applying migration %s: %w", migration.ID, err)
+		}
+		ids = append(ids, migration.ID)
+	}
+	return ids, nil
+}
+
+func (m *migrator) applyOne(ctx context.Context, migration migration) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, migration.SQL)
+	if err == nil {
+		_, err = tx.ExecContext(ctx, "INSERT INTO "+m.quotedTable()+" (id, checksum) VALUES (?, ?)", migration.ID, migration.Checksum)
+	}
+	if err != nil {
+		// A script of several statements is rolled back by libsql-client-go
+		// when one of them fails, in which case rolling back again fails.
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
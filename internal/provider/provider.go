@@ -2,16 +2,22 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/exec"
 	"strings"
+	"time"
 
 	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"golang.org/x/oauth2"
 )
@@ -19,6 +25,8 @@ import (
 // Ensure TursoProvider satisfies various provider interfaces.
 var _ provider.Provider = &TursoProvider{}
 var _ provider.ProviderWithFunctions = &TursoProvider{}
+var _ provider.ProviderWithListResources = &TursoProvider{}
+var _ provider.ProviderWithActions = &TursoProvider{}
 
 // TursoProvider defines the provider implementation.
 type TursoProvider struct {
@@ -32,12 +40,62 @@ type TursoProvider struct {
 type TursoProviderModel struct {
 	Organization types.String `tfsdk:"organization"`
 	ApiToken     types.String `tfsdk:"api_token"`
+	Parallelism  types.Int64  `tfsdk:"parallelism"`
+
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+}
+
+// defaultParallelism is the number of concurrent API requests used when
+// fanning out per-location operations, unless configured otherwise.
+const defaultParallelism = 4
+
+// Default timeouts for resource operations, unless configured otherwise in a
+// timeouts block.
+const (
+	defaultCreateTimeout = 20 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 20 * time.Minute
+	defaultDeleteTimeout = 10 * time.Minute
+)
+
+// rollbackTimeout bounds the requests which undo a failed operation. The
+// failure is often the operation timing out, so they get a deadline of their
+// own, see rollbackContext.
+const rollbackTimeout = 2 * time.Minute
+
+// rollbackContext returns a context for undoing an operation which failed
+// under ctx. It keeps the values of ctx but is not cancelled with it.
+func rollbackContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), rollbackTimeout)
 }
 
 // tursoProviderConfig holds common config for the provider.
 type tursoProviderConfig struct {
 	Organization string
 	Client       *tursoclient.Client
+
+	// Parallelism is the maximum number of concurrent API requests for
+	// operations which fan out, like adding locations to a group.
+	Parallelism int
+
+	// PollInterval is the delay between checks while waiting for databases
+	// to become ready.
+	PollInterval time.Duration
+
+	// HealthCheckURL returns the URL checked to determine whether a database
+	// hostname is serving requests.
+	HealthCheckURL func(hostname string) string
+
+	// DatabaseURL returns the URL of the libSQL HTTP API served by a database
+	// hostname.
+	DatabaseURL func(hostname string) string
+
+	// DeletionProtection is planned for databases and groups which are
+	// created without configuring deletion_protection.
+	DeletionProtection bool
+
+	// tokens caches the database tokens minted by the provider.
+	tokens databaseTokens
 }
 
 func (p *TursoProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -57,10 +115,45 @@ func (p *TursoProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"parallelism": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of concurrent API requests when an operation fans out, like adding or removing the locations of a group. Defaults to %d.", defaultParallelism),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "The default `deletion_protection` of databases and groups which are created without setting it. Defaults to `true`.",
+				Optional:            true,
+			},
 		},
 	}
 }
 
+// DefaultAPIURL is the base URL of the Turso Platform API.
+const DefaultAPIURL = "https://api.turso.tech"
+
+// DefaultAPIToken returns the API token used when none is configured: the
+// TURSO_API_TOKEN environment variable, or else the token of the Turso CLI. It
+// returns an empty string if neither is available.
+func DefaultAPIToken() string {
+	if token, ok := os.LookupEnv("TURSO_API_TOKEN"); ok {
+		return token
+	}
+	out, err := exec.Command("turso", "auth", "token").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// NewClient returns a client for the Turso Platform API at baseURL which
+// authenticates with apiToken.
+func NewClient(ctx context.Context, baseURL, apiToken string) (*tursoclient.Client, error) {
+	authClient := oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: apiToken}))
+	return tursoclient.NewClient(baseURL, tursoclient.WithClient(authClient))
+}
+
 func (p *TursoProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var config TursoProviderModel
 
@@ -73,13 +166,8 @@ func (p *TursoProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	var apiToken string
 	if !config.ApiToken.IsNull() && !config.ApiToken.IsUnknown() {
 		apiToken = config.ApiToken.ValueString()
-	} else if token, ok := os.LookupEnv("TURSO_API_TOKEN"); ok {
-		apiToken = token
 	} else {
-		out, err := exec.Command("turso", "auth", "token").Output()
-		if err == nil {
-			apiToken = strings.TrimSpace(string(out))
-		}
+		apiToken = DefaultAPIToken()
 	}
 
 	if apiToken == "" {
@@ -87,24 +175,57 @@ func (p *TursoProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
-	authClient := oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: apiToken}))
-	client, err := tursoclient.NewClient("https://api.turso.tech", tursoclient.WithClient(authClient))
+	client, err := NewClient(ctx, DefaultAPIURL, apiToken)
 	if err != nil {
 		resp.Diagnostics.AddError(err.Error(), err.Error())
 		return
 	}
+	parallelism := defaultParallelism
+	if isProvided(config.Parallelism) {
+		parallelism = int(config.Parallelism.ValueInt64())
+	}
+	deletionProtection := true
+	if isProvided(config.DeletionProtection) {
+		deletionProtection = config.DeletionProtection.ValueBool()
+	}
 	providerConfig := &tursoProviderConfig{
-		Organization: config.Organization.ValueString(),
-		Client:       client,
+		Organization:       config.Organization.ValueString(),
+		Client:             client,
+		Parallelism:        parallelism,
+		DeletionProtection: deletionProtection,
+
+		PollInterval:   defaultPollInterval,
+		HealthCheckURL: defaultHealthCheckURL,
+		DatabaseURL:    httpURL,
 	}
 	resp.DataSourceData = providerConfig
 	resp.ResourceData = providerConfig
+	resp.ListResourceData = providerConfig
+	resp.ActionData = providerConfig
 }
 
 func (p *TursoProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewDatabaseResource,
+		NewDatabaseMigrationResource,
 		NewGroupResource,
+		NewTokenInvalidationResource,
+	}
+}
+
+func (p *TursoProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewDatabaseListResource,
+		NewGroupListResource,
+	}
+}
+
+func (p *TursoProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewInvalidateDatabaseTokensAction,
+		NewInvalidateGroupTokensAction,
+		NewUnarchiveGroupAction,
+		NewUpgradeGroupAction,
 	}
 }
 
@@ -114,6 +235,7 @@ func (p *TursoProvider) DataSources(ctx context.Context) []func() datasource.Dat
 		NewDatabasesDataSource,
 		NewDatabaseTokenDataSource,
 		NewDatabaseInstancesDataSource,
+		NewDatabaseQueryDataSource,
 		NewGroupTokenDataSource,
 		NewGroupDataSource,
 		NewGroupsDataSource,
@@ -121,7 +243,12 @@ func (p *TursoProvider) DataSources(ctx context.Context) []func() datasource.Dat
 }
 
 func (p *TursoProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewDatabaseURLFunction,
+		NewParseDatabaseURLFunction,
+		NewJWTClaimsFunction,
+		NewParseSizeFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -26,7 +26,8 @@ func testAccPreCheck(t *testing.T) {
 func testAccCreateConfig(config string) string {
 	return `
 provider "turso" {
-	organization = "celest-dev"
+	organization        = "celest-dev"
+	deletion_protection = false
 }
 	` + config
 }
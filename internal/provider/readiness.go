@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultPollInterval is the delay between checks while waiting for a
+// database to become ready.
+const defaultPollInterval = 2 * time.Second
+
+// defaultHealthCheckURL returns the health endpoint served by libSQL server for
+// the given database hostname.
+func defaultHealthCheckURL(hostname string) string {
+	return "https://" + hostname + "/health"
+}
+
+// waitForDatabaseReady waits until the database has an instance in every
+// location of its group and its hostname answers health checks. It returns
+// when ctx is done, with the reason the database was not yet ready.
+func (r *tursoProviderConfig) waitForDatabaseReady(ctx context.Context, name string) diag.Diagnostics {
+	tflog.Debug(ctx, "waiting for database to be ready", map[string]interface{}{
+		"database": name,
+	})
+	err := r.poll(ctx, func(ctx context.Context) error {
+		return r.checkDatabaseReady(ctx, name)
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic("Database not ready", fmt.Sprintf("Database %q did not become ready: %s", name, err.Error())),
+		}
+	}
+	return nil
+}
+
+// waitForGroupReady waits until every database in the group is ready, see
+// waitForDatabaseReady.
+func (r *tursoProviderConfig) waitForGroupReady(ctx context.Context, name string) diag.Diagnostics {
+	tflog.Debug(ctx, "waiting for group to be ready", map[string]interface{}{
+		"group": name,
+	})
+	err := r.poll(ctx, func(ctx context.Context) error {
+		res, err := r.Client.ListDatabases(ctx, tursoclient.ListDatabasesParams{
+			OrganizationName: r.Organization,
+			Group:            tursoclient.NewOptString(name),
+		})
+		if err != nil {
+			return err
+		}
+		names := make([]string, len(res.Databases))
+		for i, db := range res.Databases {
+			names[i] = db.Name.Value
+		}
+		return errors.Join(forEachParallel(ctx, r.Parallelism, names, r.checkDatabaseReady)...)
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic("Group not ready", fmt.Sprintf("Group %q did not become ready: %s", name, err.Error())),
+		}
+	}
+	return nil
+}
+
+// checkDatabaseReady returns nil if the database is ready, or an error
+// describing what it is still waiting for. The regions reported for the
+// database lag behind the locations added to its group, so an instance is
+// required in each location of the group as well.
+func (r *tursoProviderConfig) checkDatabaseReady(ctx context.Context, name string) error {
+	db, diags := r.readDatabase(ctx, name)
+	if diags.HasError() {
+		return fmt.Errorf("database %q: %s", name, diags[0].Detail())
+	}
+	group, diags := r.readGroup(ctx, db.Group.Value)
+	if diags.HasError() {
+		return fmt.Errorf("group %q of database %q: %s", db.Group.Value, name, diags[0].Detail())
+	}
+
+	res, err := r.Client.ListDatabaseInstances(ctx, tursoclient.ListDatabaseInstancesParams{
+		OrganizationName: r.Organization,
+		DatabaseName:     name,
+	})
+	if err != nil {
+		return fmt.Errorf("database %q: %w", name, err)
+	}
+	regions := make([]string, len(res.Instances))
+	for i, instance := range res.Instances {
+		regions[i] = instance.Region.Value
+	}
+	for _, region := range slices.Concat(group.Locations, db.Regions) {
+		if !slices.Contains(regions, region) {
+			return fmt.Errorf("database %q has no instance in %s yet", name, region)
+		}
+	}
+
+	return r.checkHealth(ctx, db.Hostname.Value)
+}
+
+// checkHealth returns nil if the hostname answers its health endpoint.
+func (r *tursoProviderConfig) checkHealth(ctx context.Context, hostname string) error {
+	healthCheckURL := r.HealthCheckURL
+	if healthCheckURL == nil {
+		healthCheckURL = defaultHealthCheckURL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, healthCheckURL(hostname), nil)
+	if err != nil {
+		return err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("health check for %s failed: %w", hostname, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("health check for %s returned %s", hostname, res.Status)
+	}
+	return nil
+}
+
+// poll calls check until it returns nil or ctx is done. In the latter case, it
+// returns the last error from check which was not caused by ctx being done.
+func (r *tursoProviderConfig) poll(ctx context.Context, check func(context.Context) error) error {
+	interval := r.PollInterval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var lastErr error
+	for {
+		err := check(ctx)
+		if err == nil {
+			return nil
+		}
+		if lastErr == nil || ctx.Err() == nil {
+			lastErr = err
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %w", ctx.Err(), lastErr)
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWaitForDatabaseReady(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	config := fake.start(t)
+	config.PollInterval = 10 * time.Millisecond
+
+	var healthy atomic.Bool
+	health := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" || !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(health.Close)
+	config.HealthCheckURL = func(hostname string) string {
+		return health.URL + "/health"
+	}
+
+	r := &GroupResource{tursoProviderConfig: config}
+	createGroup(t, ctx, r, "test", "sjc", "sjc", "dfw")
+	fake.addDatabase("db", "test", "db-test-org.turso.io")
+
+	// Instances come up one at a time, then the hostname starts serving.
+	go func() {
+		time.Sleep(30 * time.Millisecond)
+		fake.addInstance("db", "sjc")
+		time.Sleep(30 * time.Millisecond)
+		fake.addInstance("db", "dfw")
+		time.Sleep(30 * time.Millisecond)
+		healthy.Store(true)
+	}()
+
+	if diags := config.waitForDatabaseReady(ctx, "db"); diags.HasError() {
+		t.Fatalf("expected database to become ready, got %v", diags)
+	}
+	if diags := config.waitForGroupReady(ctx, "test"); diags.HasError() {
+		t.Fatalf("expected group to become ready, got %v", diags)
+	}
+}
+
+func TestWaitForDatabaseReady_Timeout(t *testing.T) {
+	fake := newFakeTurso()
+	config := fake.start(t)
+	config.PollInterval = 10 * time.Millisecond
+	config.HealthCheckURL = func(hostname string) string {
+		t.Fatalf("unexpected health check before all instances are up")
+		return ""
+	}
+
+	r := &GroupResource{tursoProviderConfig: config}
+	createGroup(t, context.Background(), r, "test", "sjc", "sjc", "dfw")
+	fake.addDatabase("db", "test", "db-test-org.turso.io")
+	fake.addInstance("db", "sjc")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	diags := config.waitForDatabaseReady(ctx, "db")
+	if !diags.HasError() {
+		t.Fatalf("expected timeout, got none")
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, "no instance in dfw") {
+		t.Errorf("expected error to name the missing location, got %q", detail)
+	}
+}
+
+func TestWaitForDatabaseReady_WaitsForGroupLocations(t *testing.T) {
+	fake := newFakeTurso()
+	config := fake.start(t)
+	config.PollInterval = 10 * time.Millisecond
+	config.HealthCheckURL = func(hostname string) string {
+		t.Fatalf("unexpected health check before all instances are up")
+		return ""
+	}
+
+	r := &GroupResource{tursoProviderConfig: config}
+	createGroup(t, context.Background(), r, "test", "sjc", "sjc", "dfw")
+	// The database does not report the location added to its group yet.
+	fake.addDatabase("db", "test", "db-test-org.turso.io").Regions = []string{"sjc"}
+	fake.addInstance("db", "sjc")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	diags := config.waitForDatabaseReady(ctx, "db")
+	if !diags.HasError() {
+		t.Fatalf("expected timeout, got none")
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, "no instance in dfw") {
+		t.Errorf("expected error to name the missing group location, got %q", detail)
+	}
+}
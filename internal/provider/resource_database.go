@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/celest-dev/terraform-provider-turso/internal/resource_database"
 	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
@@ -10,6 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -17,6 +22,10 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &DatabaseResource{}
 var _ resource.ResourceWithImportState = &DatabaseResource{}
+var _ resource.ResourceWithIdentity = &DatabaseResource{}
+var _ resource.ResourceWithConfigValidators = &DatabaseResource{}
+var _ resource.ResourceWithModifyPlan = &DatabaseResource{}
+var _ resource.ResourceWithUpgradeState = &DatabaseResource{}
 
 func NewDatabaseResource() resource.Resource {
 	return &DatabaseResource{}
@@ -32,6 +41,167 @@ func (r *DatabaseResource) Metadata(ctx context.Context, req resource.MetadataRe
 
 func (r *DatabaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_database.DatabaseResourceSchema(ctx)
+	// Bump the version and add an upgrader to UpgradeState whenever saved
+	// state is not compatible with the schema anymore.
+	resp.Schema.Version = 1
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceTimeoutsBlock(ctx),
+	}
+
+	// The hash of the dump file is planned from the file on disk, so changing
+	// its contents plans a new database.
+	seedAttr, ok := resp.Schema.Attributes["seed"].(schema.SingleNestedAttribute)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to configure seed attribute", "Failed to configure seed attribute")
+		return
+	}
+	dumpFileHashAttr, ok := seedAttr.Attributes["dump_file_hash"].(schema.StringAttribute)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to configure dump_file_hash attribute", "Failed to configure dump_file_hash attribute")
+		return
+	}
+	dumpFileHashAttr.PlanModifiers = append(dumpFileHashAttr.PlanModifiers, dumpFileHashModifier{})
+	seedAttr.Attributes["dump_file_hash"] = dumpFileHashAttr
+
+	// The seed only applies when creating the database, so the attributes
+	// reported for it are kept from the state.
+	if err := useStateForUnknown(seedAttr.Attributes, "name", "timestamp", "type", "url"); err != nil {
+		resp.Diagnostics.AddError("Failed to configure seed attribute", err.Error())
+		return
+	}
+
+	// The database a database was seeded from never changes after creation.
+	seededFromAttr, ok := resp.Schema.Attributes["seeded_from"].(schema.SingleNestedAttribute)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to configure seeded_from attribute", "Failed to configure seeded_from attribute")
+		return
+	}
+	seededFromAttr.PlanModifiers = append(seededFromAttr.PlanModifiers, objectplanmodifier.UseStateForUnknown())
+	resp.Schema.Attributes["seeded_from"] = seededFromAttr
+
+	// Most attributes of the database never change once it is created, so they
+	// are kept from the state rather than shown as known after apply.
+	databaseAttr, ok := resp.Schema.Attributes["database"].(schema.SingleNestedAttribute)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to configure database attribute", "Failed to configure database attribute")
+		return
+	}
+	databaseAttr.PlanModifiers = append(databaseAttr.PlanModifiers, unknownAttributesModifier{})
+	if err := useStateForUnknown(databaseAttr.Attributes,
+		"allow_attach", "block_reads", "block_writes", "db_id", "group", "hostname", "http_url",
+		"is_schema", "libsql_url", "name", "primary_region", "regions", "schema", "type",
+	); err != nil {
+		resp.Diagnostics.AddError("Failed to configure database attribute", err.Error())
+		return
+	}
+	resp.Schema.Attributes["database"] = databaseAttr
+
+	// Names are checked when planning, rather than failing at the API after
+	// other resources have been created.
+	for _, name := range []string{"name", "group"} {
+		nameAttr, ok := resp.Schema.Attributes[name].(schema.StringAttribute)
+		if !ok {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to configure %s attribute", name), fmt.Sprintf("Failed to configure %s attribute", name))
+			return
+		}
+		nameAttr.Validators = append(nameAttr.Validators, nameValidators()...)
+		resp.Schema.Attributes[name] = nameAttr
+	}
+
+	sizeLimitAttr, ok := resp.Schema.Attributes["size_limit"].(schema.StringAttribute)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to configure size_limit attribute", "Failed to configure size_limit attribute")
+		return
+	}
+	sizeLimitAttr.Validators = append(sizeLimitAttr.Validators, sizeValidator{})
+	resp.Schema.Attributes["size_limit"] = sizeLimitAttr
+}
+
+func (r *DatabaseResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema("database")
+}
+
+// UpgradeState upgrades state saved with earlier versions of the schema.
+func (r *DatabaseResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				upgradeStateJSON(ctx, req, resp, upgradeDatabaseStateV0)
+			},
+		},
+	}
+}
+
+// upgradeDatabaseStateV0 upgrades the state of a database saved before the
+// schema was versioned, by providers which only ever added attributes. Those
+// are null, except for the ones Read would default. Deletion protection stays
+// off until it is saved by an apply.
+func upgradeDatabaseStateV0(attributes map[string]any) {
+	setDefault(attributes, "wait_for_ready", false)
+}
+
+type databaseConfigValidator struct{}
+
+var _ resource.ConfigValidator = &databaseConfigValidator{}
+
+// Description implements resource.ConfigValidator.
+func (p *databaseConfigValidator) Description(context.Context) string {
+	return "Validate the database configuration."
+}
+
+// MarkdownDescription implements resource.ConfigValidator.
+func (p *databaseConfigValidator) MarkdownDescription(context.Context) string {
+	return "Validate the database configuration."
+}
+
+// ValidateResource implements resource.ConfigValidator.
+func (p *databaseConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var isSchema types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("is_schema"), &isSchema)...)
+	var schemaName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("schema"), &schemaName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isSchema.ValueBool() && isProvided(schemaName) {
+		resp.Diagnostics.AddAttributeError(path.Root("schema"), "Invalid schema", "A schema database cannot itself use a schema database.")
+		return
+	}
+
+	var seed resource_database.SeedValue
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("seed"), &seed)...)
+	if resp.Diagnostics.HasError() || !isProvided(seed) {
+		return
+	}
+
+	if isProvided(seed.DumpFile) && isProvided(seed.SeedType) && seed.SeedType.ValueString() != seedTypeDump {
+		resp.Diagnostics.AddAttributeError(path.Root("seed").AtName("type"), "Invalid seed type", "The seed type must be `dump` when dump_file is specified.")
+		return
+	}
+
+	source, _ := seedSource(seed)
+	if isProvided(source) && isProvided(seed.SeedType) && seed.SeedType.ValueString() != seedTypeDatabase {
+		resp.Diagnostics.AddAttributeError(path.Root("seed").AtName("type"), "Invalid seed type", "The seed type must be `database` when database or name is specified.")
+		return
+	}
+
+	if isProvided(seed.Timestamp) {
+		if source.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("seed").AtName("timestamp"), "Missing seed database", "The database to branch from at timestamp must be specified in database.")
+			return
+		}
+		if err := validateSeedTimestamp(seed.Timestamp.ValueString(), time.Now()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("seed").AtName("timestamp"), "Invalid seed timestamp", fmt.Sprintf("Unable to parse seed timestamp, got error: %s", err))
+			return
+		}
+	}
+}
+
+func (r *DatabaseResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		&databaseConfigValidator{},
+	}
 }
 
 func (r *DatabaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,6 +223,102 @@ func (r *DatabaseResource) Configure(ctx context.Context, req resource.Configure
 	r.tursoProviderConfig = client
 }
 
+// ModifyPlan plans the default deletion protection, and checks that the
+// databases a new database is seeded from or uses as its schema exist and are
+// in the same group, and plans the seeded_from record. For an existing
+// database, it plans a move to another group as a replacement and the settings
+// reported in the database attribute from the configured ones. The database
+// which replaces a moved one is planned from the original.
+func (r *DatabaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(clearDatabaseMove(ctx, resp.Private)...)
+		return
+	}
+	resp.Diagnostics.Append(r.tursoProviderConfig.planDeletionProtection(ctx, req.Config, req.State, &resp.Plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state resource_database.DatabaseModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(planDatabaseMove(ctx, state, resp)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(planDatabaseSettings(ctx, &resp.Plan)...)
+		return
+	}
+
+	// The seed and schema of a moved database are kept from the original,
+	// which is copied rather than seeded again.
+	move, moving, diags := readDatabaseMove(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if moving {
+		resp.Diagnostics.Append(planMovedDatabase(ctx, move, &resp.Plan)...)
+		return
+	}
+
+	// The seed and schema can only be set when creating a database, and the
+	// provider must be configured to look up the databases they refer to.
+	if r.tursoProviderConfig == nil {
+		return
+	}
+
+	var group types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("group"), &group)...)
+	var schemaName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("schema"), &schemaName)...)
+	var seed resource_database.SeedValue
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("seed"), &seed)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The schema database may be created in the same apply, in which case it
+	// is checked when the database is created.
+	if isProvided(schemaName) {
+		_, _, diags := r.getSchemaDatabase(ctx, schemaName.ValueString(), group)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	seededFrom, diags := r.planSeededFrom(ctx, group, seed)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("seeded_from"), seededFrom)...)
+}
+
+// planDatabaseSettings plans the settings in the database attribute of an
+// existing database from the top-level settings, when they are known.
+func planDatabaseSettings(ctx context.Context, plan *tfsdk.Plan) diag.Diagnostics {
+	var database resource_database.DatabaseValue
+	diags := plan.GetAttribute(ctx, path.Root("database"), &database)
+	if diags.HasError() || !isProvided(database) {
+		return diags
+	}
+	for _, name := range []string{"allow_attach", "block_reads", "block_writes"} {
+		var setting types.Bool
+		diags.Append(plan.GetAttribute(ctx, path.Root(name), &setting)...)
+		if diags.HasError() {
+			return diags
+		}
+		if isProvided(setting) {
+			diags.Append(plan.SetAttribute(ctx, path.Root("database").AtName(name), setting)...)
+		}
+	}
+	return diags
+}
+
 func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resource_database.DatabaseModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -60,26 +326,82 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Trace(ctx, "create database plan", map[string]interface{}{
 		"plan": data,
 	})
 	fmt.Printf("create database plan: %+v\n", data)
 
-	var dbSeed tursoclient.OptCreateDatabaseInputSeed
-	if isProvided(data.Seed) {
-		dbSeed = tursoclient.NewOptCreateDatabaseInputSeed(tursoclient.CreateDatabaseInputSeed{
-			Type:      tursoclient.NewOptCreateDatabaseInputSeedType(tursoclient.CreateDatabaseInputSeedType(data.Seed.SeedType.ValueString())),
-			Name:      optString(data.Seed.Name),
-			URL:       optString(data.Seed.Url),
-			Timestamp: optString(data.Seed.Timestamp),
-		})
+	_, moving, diags := readDatabaseMove(ctx, resp.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if moving {
+		resp.Diagnostics.Append(clearDatabaseMove(ctx, resp.Private)...)
+		save, diags := r.createMovedDatabase(ctx, &data, createTimeout)
+		resp.Diagnostics.Append(diags...)
+		if !save {
+			return
+		}
+		resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, data.Name.ValueString())...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if data.WaitForReady.ValueBool() {
+			resp.Diagnostics.Append(r.waitForDatabaseReady(ctx, data.Name.ValueString())...)
+		}
+		return
+	}
+
+	if isProvided(data.Schema) {
+		resp.Diagnostics.Append(r.checkSchemaDatabase(ctx, data.Schema.ValueString(), data.Group)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	dbSeed, diags := r.createDatabaseSeed(ctx, &data.Seed)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seededFrom, diags := r.seededFrom(ctx, data.Group, data.Seed)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if isProvided(data.SeededFrom) && !data.SeededFrom.DatabaseId.Equal(seededFrom.DatabaseId) {
+		_, sourcePath := seedSource(data.Seed)
+		resp.Diagnostics.AddAttributeError(
+			sourcePath,
+			"Seed database changed",
+			fmt.Sprintf("The database %q to seed from was replaced after the plan was created. Run terraform plan again to seed from the current database.", seededFrom.Database.ValueString()),
+		)
+		return
+	}
+	data.SeededFrom = seededFrom
+
+	sizeLimit, err := optSize(data.SizeLimit)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("size_limit"), "Invalid size", err.Error())
+		return
 	}
 
 	createReq := tursoclient.CreateDatabaseInput{
 		Name:      data.Name.ValueString(),
 		Group:     data.Group.ValueString(),
 		Seed:      dbSeed,
-		SizeLimit: optString(data.SizeLimit),
+		SizeLimit: sizeLimit,
 		IsSchema:  optBool(data.IsSchema),
 		Schema:    optString(data.Schema),
 	}
@@ -101,11 +423,31 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	dbName := string(db.Database.Value.Name.Value)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, dbName)...)
 	resp.Diagnostics.Append(r.readDatabaseResource(ctx, dbName, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.updateDatabaseConfiguration(ctx, dbName, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created database resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WaitForReady.ValueBool() {
+		resp.Diagnostics.Append(r.waitForDatabaseReady(ctx, dbName)...)
+	}
+}
+
+// updateDatabaseConfiguration applies the configured settings to the database
+// and records them in data.
+func (r *DatabaseResource) updateDatabaseConfiguration(ctx context.Context, name string, data *resource_database.DatabaseModel) diag.Diagnostics {
 	if isProvided(data.AllowAttach) || isProvided(data.BlockReads) || isProvided(data.BlockWrites) {
 		allowAttach := data.AllowAttach.ValueBool()
 		blockReads := data.BlockReads.ValueBool()
@@ -127,11 +469,12 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 			},
 		}, tursoclient.UpdateDatabaseConfigurationParams{
 			OrganizationName: r.Organization,
-			DatabaseName:     dbName,
+			DatabaseName:     name,
 		})
 		if err != nil {
-			resp.Diagnostics.AddError("error updating database configuration", err.Error())
-			return
+			return diag.Diagnostics{
+				diag.NewErrorDiagnostic("error updating database configuration", err.Error()),
+			}
 		}
 
 		// Update the data model with the new values
@@ -143,9 +486,7 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		data.Database.BlockReads = types.BoolValue(blockReads)
 		data.Database.BlockWrites = types.BoolValue(blockWrites)
 	}
-
-	tflog.Trace(ctx, "created database resource")
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return nil
 }
 
 func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -156,17 +497,177 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Only the name is known for a database which was just imported.
+	imported := data.Database.IsNull()
 	resp.Diagnostics.Append(r.readDatabaseResource(ctx, data.Name.ValueString(), &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if imported {
+		resp.Diagnostics.Append(r.readImportedDatabaseSettings(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, data.Name.ValueString())...)
+}
+
+// readImportedDatabaseSettings reads the settings of an imported database,
+// which are otherwise only known from the configuration.
+func (r *DatabaseResource) readImportedDatabaseSettings(ctx context.Context, data *resource_database.DatabaseModel) diag.Diagnostics {
+	res, err := r.Client.GetDatabaseConfiguration(ctx, tursoclient.GetDatabaseConfigurationParams{
+		OrganizationName: r.Organization,
+		DatabaseName:     data.Name.ValueString(),
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Unable to read database configuration, got error: %s", err)),
+		}
+	}
+	tflog.Debug(ctx, "read imported database configuration", map[string]interface{}{
+		"database": data.Name.ValueString(),
+	})
+
+	data.AllowAttach = types.BoolValue(res.AllowAttach.Value)
+	data.BlockReads = types.BoolValue(res.BlockReads.Value)
+	data.BlockWrites = types.BoolValue(res.BlockWrites.Value)
+	data.SizeLimit = types.StringNull()
+	if sizeLimit := res.SizeLimit.Value; sizeLimit != "" && sizeLimit != "0" {
+		data.SizeLimit = types.StringValue(sizeLimit)
+	}
+	// As when creating a database, the schema settings are only recorded
+	// when they are in use.
+	if data.Database.IsSchema.ValueBool() {
+		data.IsSchema = types.BoolValue(true)
+	}
+	if schemaName := data.Database.Schema.ValueString(); schemaName != "" {
+		data.Schema = types.StringValue(schemaName)
+	}
+	return nil
 }
 
 func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("not implemented", "database resource does not support updates")
+	var data resource_database.DatabaseModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var curr resource_database.DatabaseModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &curr)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Resources created by earlier versions of the provider have no identity
+	// until they are read.
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, curr.Name.ValueString())...)
+
+	// The seed of an imported database is not reported by the API, so the
+	// configured one is recorded rather than treated as a change.
+	imported, diags := req.Private.GetKey(ctx, importedKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(imported) > 0 {
+		if !isProvided(curr.Seed) {
+			curr.Seed = data.Seed
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedKey, nil)...)
+	}
+
+	if !onlyProviderSettingsChanged(data, curr) {
+		resp.Diagnostics.AddError("not implemented", "database resource does not support updates")
+		return
+	}
+
+	// Only settings which are local to the provider changed, or the size limit
+	// was written differently.
+	curr.WaitForReady = data.WaitForReady
+	curr.DeletionProtection = data.DeletionProtection
+	curr.FinalSnapshot = data.FinalSnapshot
+	if isProvided(data.SizeLimit) {
+		curr.SizeLimit = data.SizeLimit
+	}
+	curr.Seed = updatedSeed(curr.Seed, data.Seed)
+	curr.Timeouts = data.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &curr)...)
+}
+
+// updatedSeed returns the seed saved by an update. The seed only applies when
+// the database is created, so it is kept from the state and only the path to
+// the dump file and the attributes the source database is set in are taken
+// from the plan. A seed recorded from the configuration
+// of an imported database has the attributes which are only set when creating
+// the database stored as null.
+func updatedSeed(state, plan resource_database.SeedValue) resource_database.SeedValue {
+	if !isProvided(state) {
+		return state
+	}
+	if isProvided(plan) {
+		state.DumpFile = plan.DumpFile
+		state.Database = plan.Database
+		if !plan.Name.IsUnknown() {
+			state.Name = plan.Name
+		}
+	}
+	for _, v := range []*types.String{&state.DumpFile, &state.DumpFileHash, &state.Name, &state.Timestamp, &state.SeedType, &state.Url} {
+		if v.IsUnknown() {
+			*v = types.StringNull()
+		}
+	}
+	return state
+}
+
+// onlyProviderSettingsChanged reports whether the planned database differs from
+// its state only in settings which are local to the provider, or in how the
+// same size limit is written, and so can be updated without calling the API.
+func onlyProviderSettingsChanged(plan, state resource_database.DatabaseModel) bool {
+	unchanged := func(planned, current attr.Value) bool {
+		return !isProvided(planned) || planned.Equal(current)
+	}
+	return unchanged(plan.Group, state.Group) &&
+		unchanged(plan.Name, state.Name) &&
+		unchanged(plan.IsSchema, state.IsSchema) &&
+		unchanged(plan.Schema, state.Schema) &&
+		unchanged(comparableSeed(plan.Seed), comparableSeed(state.Seed)) &&
+		unchanged(plan.AllowAttach, state.AllowAttach) &&
+		unchanged(plan.BlockReads, state.BlockReads) &&
+		unchanged(plan.BlockWrites, state.BlockWrites) &&
+		(!isProvided(plan.SizeLimit) || sameSize(plan.SizeLimit, state.SizeLimit))
+}
+
+// comparableSeed returns the seed without the path to the dump file, and with
+// the source database in name. The path can change without replacing the
+// database as long as the contents of the dump, tracked by dump_file_hash,
+// stay the same, and the source database can be moved from name to database.
+func comparableSeed(seed resource_database.SeedValue) resource_database.SeedValue {
+	if isProvided(seed) {
+		seed.DumpFile = types.StringNull()
+		seed.Name, _ = seedSource(seed)
+		seed.Database = types.StringNull()
+	}
+	return seed
 }
 
 func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -176,6 +677,55 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(checkDeletionProtection(data.DeletionProtection, "database", data.Name.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A database which is moved to another group is kept until it is copied
+	// by Create, which deletes it.
+	_, moving, diags := readDatabaseMove(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Children referencing the parent in the same configuration are destroyed
+	// first, so this only blocks children which are managed elsewhere.
+	if data.Database.IsSchema.ValueBool() {
+		children, err := r.listSchemaChildren(ctx, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list child databases, got error: %s", err))
+			return
+		}
+		if len(children) > 0 {
+			resp.Diagnostics.AddError(
+				"Schema database has children",
+				fmt.Sprintf("Database %q is the schema database of %s. Delete the child databases before deleting it.", data.Name.ValueString(), strings.Join(children, ", ")),
+			)
+			return
+		}
+	}
+
+	if isProvided(data.FinalSnapshot) {
+		resp.Diagnostics.Append(r.writeFinalSnapshot(ctx, data.Name.ValueString(), data.FinalSnapshot.ValueString(), deleteTimeout)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if moving {
+		return
+	}
+
 	fmt.Printf("delete database: %+v\n", data)
 	_, err := r.Client.DeleteDatabase(ctx, tursoclient.DeleteDatabaseParams{
 		OrganizationName: r.Organization,
@@ -187,9 +737,21 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 }
 
+// ImportState imports a database by its name, optionally qualified by the
+// organization as organization/name, or by its identity. The settings of the
+// database are read back by the following Read, and the seed is taken from the
+// configuration by the first update.
 func (r *DatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	fmt.Printf("importing database: %+v\n", req)
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	name, diags := r.tursoProviderConfig.importResourceName(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), r.tursoProviderConfig.deletionProtection())...)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, name)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedKey, []byte("true"))...)
 }
 
 func (r *tursoProviderConfig) readDatabase(ctx context.Context, name string) (tursoclient.Database, diag.Diagnostics) {
@@ -213,12 +775,40 @@ func (r *tursoProviderConfig) readDatabase(ctx context.Context, name string) (tu
 	return db, nil
 }
 
+// databaseExists reports whether the database exists, rather than failing
+// like readDatabase when it does not.
+func (r *tursoProviderConfig) databaseExists(ctx context.Context, name string) (bool, diag.Diagnostics) {
+	resp, err := r.Client.GetDatabase(ctx, tursoclient.GetDatabaseParams{
+		OrganizationName: r.Organization,
+		DatabaseName:     name,
+	})
+	if err != nil {
+		return false, diag.Diagnostics{
+			diag.NewErrorDiagnostic("client error", err.Error()),
+		}
+	}
+	switch resp.(type) {
+	case *tursoclient.GetDatabaseOK:
+		return true, nil
+	case *tursoclient.DatabaseNotFoundResponse:
+		return false, nil
+	default:
+		return false, diag.Diagnostics{
+			diag.NewErrorDiagnostic("client error", fmt.Sprintf("unexpected response: %+v", resp)),
+		}
+	}
+}
+
 func (r *DatabaseResource) readDatabaseResource(ctx context.Context, name string, data *resource_database.DatabaseModel) diag.Diagnostics {
 	db, diags := r.readDatabase(ctx, name)
 	if diags.HasError() {
 		return diags
 	}
+	return r.setDatabaseResource(ctx, db, data)
+}
 
+// setDatabaseResource records the database reported by the API in data.
+func (r *DatabaseResource) setDatabaseResource(ctx context.Context, db tursoclient.Database, data *resource_database.DatabaseModel) diag.Diagnostics {
 	data.Id = types.StringValue(db.Name.Value)
 	data.Name = types.StringValue(db.Name.Value)
 	data.Group = types.StringValue(db.Group.Value)
@@ -234,11 +824,29 @@ func (r *DatabaseResource) readDatabaseResource(ctx context.Context, name string
 	if data.Seed.IsUnknown() {
 		data.Seed = resource_database.NewSeedValueNull()
 	}
+	if data.SeededFrom.IsUnknown() {
+		data.SeededFrom = resource_database.NewSeededFromValueNull()
+	}
+	data.Children = types.ListNull(types.StringType)
+	if db.IsSchema.Value {
+		children, err := r.listSchemaChildren(ctx, db.Name.Value)
+		if err != nil {
+			return diag.Diagnostics{
+				diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Unable to list child databases, got error: %s", err)),
+			}
+		}
+		data.Children = encodeStringList(children)
+	}
+	if !isProvided(data.WaitForReady) {
+		data.WaitForReady = types.BoolValue(false)
+	}
 	dbVal, diags := resource_database.NewDatabaseValue(resource_database.DatabaseValue{}.AttributeTypes(ctx), map[string]attr.Value{
 		"db_id":          types.StringValue(db.DbId.Value),
 		"name":           types.StringValue(db.Name.Value),
 		"group":          types.StringValue(db.Group.Value),
 		"hostname":       types.StringValue(db.Hostname.Value),
+		"http_url":       types.StringValue(httpURL(db.Hostname.Value)),
+		"libsql_url":     types.StringValue(libsqlURL(db.Hostname.Value)),
 		"regions":        encodeStringList(db.Regions),
 		"primary_region": types.StringValue(db.PrimaryRegion.Value),
 		"schema":         types.StringValue(db.Schema.Value),
@@ -0,0 +1,393 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/resource_database_migration"
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DatabaseMigrationResource{}
+var _ resource.ResourceWithModifyPlan = &DatabaseMigrationResource{}
+var _ resource.ResourceWithImportState = &DatabaseMigrationResource{}
+var _ resource.ResourceWithIdentity = &DatabaseMigrationResource{}
+
+// defaultTrackingTable is the default of the tracking_table attribute.
+const defaultTrackingTable = "_terraform_migrations"
+
+func NewDatabaseMigrationResource() resource.Resource {
+	return &DatabaseMigrationResource{}
+}
+
+// DatabaseMigrationResource applies SQL migrations to a database over its
+// libSQL HTTP API.
+type DatabaseMigrationResource struct {
+	*tursoProviderConfig
+}
+
+func (r *DatabaseMigrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_migration"
+}
+
+func (r *DatabaseMigrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_database_migration.DatabaseMigrationResourceSchema(ctx)
+	resp.Schema.Description = "Applies SQL migrations to a database. Each migration runs in a transaction and is recorded in a tracking table in the database, so it is applied only once. Destroying the resource does not roll back applied migrations."
+	resp.Schema.MarkdownDescription = resp.Schema.Description
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceTimeoutsBlock(ctx),
+	}
+
+	for _, name := range []string{"database", "tracking_table"} {
+		attr, ok := resp.Schema.Attributes[name].(schema.StringAttribute)
+		if !ok {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to configure %s attribute", name), fmt.Sprintf("Failed to configure %s attribute", name))
+			return
+		}
+		attr.PlanModifiers = append(attr.PlanModifiers, stringplanmodifier.RequiresReplace())
+		resp.Schema.Attributes[name] = attr
+	}
+	idAttr, ok := resp.Schema.Attributes["id"].(schema.StringAttribute)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to configure id attribute", "Failed to configure id attribute")
+		return
+	}
+	idAttr.PlanModifiers = []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
+	resp.Schema.Attributes["id"] = idAttr
+}
+
+// databaseMigrationIdentityModel is the identity of the migrations applied to
+// a database, which are tracked in one table of the database.
+type databaseMigrationIdentityModel struct {
+	Organization  types.String `tfsdk:"organization"`
+	Database      types.String `tfsdk:"database"`
+	TrackingTable types.String `tfsdk:"tracking_table"`
+}
+
+func (r *DatabaseMigrationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization the database belongs to. Defaults to the organization the provider is configured for.",
+				OptionalForImport: true,
+			},
+			"database": identityschema.StringAttribute{
+				Description:       "The name of the database.",
+				RequiredForImport: true,
+			},
+			"tracking_table": identityschema.StringAttribute{
+				Description:       "The name of the table in the database which records the applied migrations. Defaults to `" + defaultTrackingTable + "`.",
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
+// setIdentity sets the identity of the migrations applied to the database and
+// tracked in table. The identity is nil when Terraform does not support
+// resource identities.
+func (r *DatabaseMigrationResource) setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, database, table string) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, databaseMigrationIdentityModel{
+		Organization:  types.StringValue(r.Organization),
+		Database:      types.StringValue(database),
+		TrackingTable: types.StringValue(table),
+	})
+}
+
+func (r *DatabaseMigrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tursoProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *tursoProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.tursoProviderConfig = client
+}
+
+// ModifyPlan plans the migrations in migration_files as applied, and rejects
+// changes to migrations which were already applied.
+func (r *DatabaseMigrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data resource_database_migration.DatabaseMigrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.MigrationFiles.IsUnknown() {
+		return
+	}
+
+	migrations, err := loadMigrations(decodeStringList(data.MigrationFiles))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("migration_files"), "Invalid migration files", fmt.Sprintf("Unable to read migrations, got error: %s", err))
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state resource_database_migration.DatabaseMigrationModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		applied := map[string]string{}
+		resp.Diagnostics.Append(state.Checksums.ElementsAs(ctx, &applied, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := checkMigrations(migrations, applied); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("migration_files"), "Invalid migration files", err.Error())
+			return
+		}
+	}
+
+	data.AppliedMigrations, data.Checksums = encodeMigrations(migrations, nil)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+}
+
+func (r *DatabaseMigrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_database_migration.DatabaseMigrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	data.Id = data.Database
+	resp.Diagnostics.Append(r.applyMigrations(ctx, &data, createTimeout)...)
+	if data.AppliedMigrations.IsUnknown() {
+		// Nothing was applied.
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, data.Database.ValueString(), data.TrackingTable.ValueString())...)
+}
+
+func (r *DatabaseMigrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_database_migration.DatabaseMigrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// The migrations were applied to the database, so they are gone with it.
+	exists, diags := r.databaseExists(ctx, data.Database.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !exists {
+		tflog.Debug(ctx, "database of migrations not found, removing from state", map[string]interface{}{
+			"database": data.Database.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	db, diags := r.openDatabase(ctx, data.Database.ValueString(), "", tursoclient.CreateDatabaseTokenAuthorizationReadOnly, readTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer db.Close()
+
+	m := &migrator{db: db, table: data.TrackingTable.ValueString()}
+	applied, err := m.applied(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read applied migrations, got error: %s", err))
+		return
+	}
+
+	// Only the configured migrations are tracked, in their configured order.
+	// The migration files of an imported resource are not known yet, so every
+	// applied migration is tracked, ordered by ID.
+	ids := make([]string, 0, len(applied))
+	checksums := make(map[string]string, len(applied))
+	if data.MigrationFiles.IsNull() {
+		for id, checksum := range applied {
+			ids = append(ids, id)
+			checksums[id] = checksum
+		}
+		slices.Sort(ids)
+	}
+	for _, file := range decodeStringList(data.MigrationFiles) {
+		id := migrationID(file)
+		if checksum, ok := applied[id]; ok {
+			ids = append(ids, id)
+			checksums[id] = checksum
+		}
+	}
+	data.AppliedMigrations = encodeStringList(ids)
+	data.Checksums = encodeStringMap(checksums)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, data.Database.ValueString(), data.TrackingTable.ValueString())...)
+}
+
+func (r *DatabaseMigrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_database_migration.DatabaseMigrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Resources created by earlier versions of the provider have no identity
+	// until they are read.
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, data.Database.ValueString(), data.TrackingTable.ValueString())...)
+
+	resp.Diagnostics.Append(r.applyMigrations(ctx, &data, updateTimeout)...)
+	if data.AppliedMigrations.IsUnknown() {
+		// Nothing was applied, so the prior state is still accurate.
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource from the state. Applied migrations are not
+// rolled back, and the tracking table is kept, so the delete timeout is only
+// checked.
+func (r *DatabaseMigrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_database_migration.DatabaseMigrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState imports the migrations applied to a database by the name of the
+// database, optionally qualified by the organization as organization/name, and
+// followed by :tracking_table if the migrations are not tracked in the default
+// table. They can also be imported by their identity.
+func (r *DatabaseMigrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "importing database migration", map[string]interface{}{
+		"id": req.ID,
+	})
+	name, table, diags := r.importDatabaseAndTable(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if table == "" {
+		table = defaultTrackingTable
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tracking_table"), table)...)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, name, table)...)
+}
+
+// importDatabaseAndTable returns the database and tracking table of the
+// migrations being imported. The table is empty if it is not given.
+func (r *DatabaseMigrationResource) importDatabaseAndTable(ctx context.Context, req resource.ImportStateRequest) (string, string, diag.Diagnostics) {
+	if req.ID != "" || req.Identity == nil {
+		id, table, _ := strings.Cut(req.ID, ":")
+		name, diags := r.tursoProviderConfig.importName(id)
+		return name, table, diags
+	}
+
+	var identity databaseMigrationIdentityModel
+	diags := req.Identity.Get(ctx, &identity)
+	if diags.HasError() {
+		return "", "", diags
+	}
+	diags.Append(r.tursoProviderConfig.checkImportOrganization(identity.Organization)...)
+	if identity.Database.ValueString() == "" {
+		diags.AddError("Invalid import identity", "The name of the database to import migrations from must be set.")
+	}
+	return identity.Database.ValueString(), identity.TrackingTable.ValueString(), diags
+}
+
+// applyMigrations applies the migration files to the database and sets the
+// applied migrations in data. If the database could not be reached, they are
+// left unknown.
+func (r *DatabaseMigrationResource) applyMigrations(ctx context.Context, data *resource_database_migration.DatabaseMigrationModel, ttl time.Duration) diag.Diagnostics {
+	data.AppliedMigrations = types.ListUnknown(types.StringType)
+	data.Checksums = types.MapUnknown(types.StringType)
+
+	migrations, err := loadMigrations(decodeStringList(data.MigrationFiles))
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(path.Root("migration_files"), "Invalid migration files", fmt.Sprintf("Unable to read migrations, got error: %s", err)),
+		}
+	}
+
+	db, diags := r.openDatabase(ctx, data.Database.ValueString(), "", tursoclient.CreateDatabaseTokenAuthorizationFullAccess, ttl)
+	if diags.HasError() {
+		return diags
+	}
+	defer db.Close()
+
+	m := &migrator{db: db, table: data.TrackingTable.ValueString()}
+	ids, err := m.apply(ctx, migrations)
+	if ids != nil {
+		data.AppliedMigrations, data.Checksums = encodeMigrations(migrations, ids)
+	}
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to apply migrations, got error: %s", err))
+	}
+	return diags
+}
+
+// encodeMigrations returns the IDs and checksums of the migrations with the
+// given IDs, or of all migrations if ids is nil.
+func encodeMigrations(migrations []migration, ids []string) (types.List, types.Map) {
+	applied := make([]string, 0, len(migrations))
+	checksums := make(map[string]string, len(migrations))
+	for _, m := range migrations {
+		if ids != nil && !slices.Contains(ids, m.ID) {
+			continue
+		}
+		applied = append(applied, m.ID)
+		checksums[m.ID] = m.Checksum
+	}
+	return encodeStringList(applied), encodeStringMap(checksums)
+}
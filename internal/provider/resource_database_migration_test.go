@@ -0,0 +1,552 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/resource_database_migration"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccResourceDatabaseMigration(t *testing.T) {
+	name := randomName()
+	dir := t.TempDir()
+	users := writeMigration(t, dir, "0001_users.sql", "CREATE TABLE users (id INTEGER PRIMARY KEY);")
+	posts := writeMigration(t, dir, "0002_posts.sql", "CREATE TABLE posts (id INTEGER PRIMARY KEY, user_id INTEGER REFERENCES users (id));")
+
+	config := func(files ...string) string {
+		return testAccCreateConfig(`
+		resource "turso_database" "test" {
+			group = "test"
+			name = "` + name + `"
+		}
+
+		resource "turso_database_migration" "test" {
+			database = turso_database.test.name
+			migration_files = ["` + strings.Join(files, `", "`) + `"]
+		}`)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read test
+			{
+				Config: config(users),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("turso_database_migration.test", tfjsonpath.New("applied_migrations"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("0001_users"),
+					})),
+					statecheck.ExpectKnownValue("turso_database_migration.test", tfjsonpath.New("tracking_table"), knownvalue.StringExact("_terraform_migrations")),
+				},
+			},
+
+			// New migrations are applied in place
+			{
+				Config: config(users, posts),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("turso_database_migration.test", tfjsonpath.New("applied_migrations"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("0001_users"),
+						knownvalue.StringExact("0002_posts"),
+					})),
+				},
+			},
+
+			// ImportState test
+			{
+				ResourceName:            "turso_database_migration.test",
+				ImportStateId:           "celest-dev/" + name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"migration_files"},
+			},
+
+			// Applied migrations cannot be changed
+			{
+				PreConfig: func() {
+					writeMigration(t, dir, "0001_users.sql", "CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT);")
+				},
+				Config:      config(users, posts),
+				ExpectError: regexp.MustCompile(`was changed after it was applied`),
+			},
+		},
+	})
+}
+
+// TestMigrator_Sqld applies migrations to the libSQL server at
+// LIBSQL_TEST_URL, e.g. a local sqld started with `sqld --http-listen-addr
+// 127.0.0.1:8080`, authenticating with LIBSQL_TEST_AUTH_TOKEN if set.
+func TestMigrator_Sqld(t *testing.T) {
+	serverURL := os.Getenv("LIBSQL_TEST_URL")
+	if serverURL == "" {
+		t.Skip("LIBSQL_TEST_URL not set")
+	}
+	ctx := context.Background()
+	prefix := strings.ReplaceAll(randomName(), "-", "_")
+	db, err := databaseEndpoint{url: serverURL, token: os.Getenv("LIBSQL_TEST_AUTH_TOKEN")}.open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		defer db.Close()
+		for _, table := range []string{"migrations", "users", "posts"} {
+			if _, err := db.ExecContext(ctx, "DROP TABLE IF EXISTS "+prefix+"_"+table); err != nil {
+				t.Errorf("error dropping table: %v", err)
+			}
+		}
+	})
+
+	dir := t.TempDir()
+	files := []string{
+		writeMigration(t, dir, "0001_users.sql", "CREATE TABLE "+prefix+"_users (id INTEGER PRIMARY KEY);"),
+		writeMigration(t, dir, "0002_posts.sql", "CREATE TABLE "+prefix+"_posts (id INTEGER PRIMARY KEY);\nINSERT INTO missing VALUES (1);"),
+	}
+	migrations, err := loadMigrations(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &migrator{db: db, table: prefix + "_migrations"}
+	ids, err := m.apply(ctx, migrations)
+	if err == nil {
+		t.Fatalf("expected second migration to fail")
+	}
+	if !slices.Equal(ids, []string{"0001_users"}) {
+		t.Errorf("expected only the first migration to be applied, got %v", ids)
+	}
+	var count int
+	err = db.QueryRowContext(ctx, "SELECT count(*) FROM sqlite_master WHERE type = 'table' AND name = ?", prefix+"_posts").Scan(&count)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("expected failed migration to be rolled back")
+	}
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != 1 || applied["0001_users"] != migrations[0].Checksum {
+		t.Errorf("expected first migration to be tracked, got %v", applied)
+	}
+}
+
+func testMigrationSchema(t *testing.T, ctx context.Context, r *DatabaseMigrationResource) schema.Schema {
+	t.Helper()
+
+	var resp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("error building schema: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+func testMigrationModel(database string, files ...string) resource_database_migration.DatabaseMigrationModel {
+	return resource_database_migration.DatabaseMigrationModel{
+		AppliedMigrations: types.ListUnknown(types.StringType),
+		Checksums:         types.MapUnknown(types.StringType),
+		Database:          types.StringValue(database),
+		Id:                types.StringUnknown(),
+		MigrationFiles:    encodeStringList(files),
+		TrackingTable:     types.StringValue("_terraform_migrations"),
+		Timeouts:          testTimeoutsNull(),
+	}
+}
+
+func writeMigration(t *testing.T, dir, name, sql string) string {
+	t.Helper()
+
+	file := filepath.Join(dir, name)
+	if err := os.WriteFile(file, []byte(sql), 0o644); err != nil {
+		t.Fatalf("error writing migration: %v", err)
+	}
+	return file
+}
+
+// startMigrationTest returns a migration resource for the database "app",
+// which is served by the returned fake.
+func startMigrationTest(t *testing.T, ctx context.Context) (*DatabaseMigrationResource, *fakeTurso, *fakeLibsql) {
+	t.Helper()
+
+	fake := newFakeTurso()
+	config := fake.start(t)
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: config}, "test", "sjc", "sjc")
+	fake.addDatabase("app", "test", "app-test-org.turso.io")
+	db := newFakeLibsql()
+	db.start(t, config, "app")
+	return &DatabaseMigrationResource{tursoProviderConfig: config}, fake, db
+}
+
+func createMigration(t *testing.T, ctx context.Context, r *DatabaseMigrationResource, data resource_database_migration.DatabaseMigrationModel) fwresource.CreateResponse {
+	t.Helper()
+
+	s := testMigrationSchema(t, ctx, r)
+	req := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	if diags := req.Plan.Set(ctx, data); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, req, &resp)
+	return resp
+}
+
+func TestDatabaseMigrationResource_AppliesMigrations(t *testing.T) {
+	ctx := context.Background()
+	r, fake, db := startMigrationTest(t, ctx)
+	s := testMigrationSchema(t, ctx, r)
+
+	dir := t.TempDir()
+	users := writeMigration(t, dir, "0001_users.sql", "CREATE TABLE users (id INTEGER PRIMARY KEY);")
+	posts := writeMigration(t, dir, "0002_posts.sql", "CREATE TABLE posts (id INTEGER PRIMARY KEY);")
+	createResp := createMigration(t, ctx, r, testMigrationModel("app", users, posts))
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("error creating migration: %v", createResp.Diagnostics)
+	}
+
+	expected := []string{"CREATE TABLE users (id INTEGER PRIMARY KEY)", "CREATE TABLE posts (id INTEGER PRIMARY KEY)"}
+	if !slices.Equal(db.statements, expected) {
+		t.Errorf("expected migrations %v to be applied in order, got %v", expected, db.statements)
+	}
+	if calls := fake.callsTo("CreateDatabaseToken"); !slices.Equal(calls, []string{"CreateDatabaseToken app full-access 25m"}) {
+		t.Errorf("expected a full-access token valid for the create timeout and the reuse window, got %v", calls)
+	}
+
+	var state resource_database_migration.DatabaseMigrationModel
+	if diags := createResp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("error decoding state: %v", diags)
+	}
+	if got := decodeStringList(state.AppliedMigrations); !slices.Equal(got, []string{"0001_users", "0002_posts"}) {
+		t.Errorf("expected both migrations to be applied, got %v", got)
+	}
+	migrations, err := loadMigrations([]string{users, posts})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := state.Checksums.Elements()["0001_users"]; !got.Equal(types.StringValue(migrations[0].Checksum)) {
+		t.Errorf("expected checksum %s, got %s", migrations[0].Checksum, got)
+	}
+	if got := state.Id.ValueString(); got != "app" {
+		t.Errorf("expected id app, got %q", got)
+	}
+
+	// Only the new migration is applied on update.
+	comments := writeMigration(t, dir, "0003_comments.sql", "CREATE TABLE comments (id INTEGER PRIMARY KEY);")
+	plan := state
+	plan.MigrationFiles = encodeStringList([]string{users, posts, comments})
+	updateReq := fwresource.UpdateRequest{Plan: tfsdk.Plan{Schema: s}, State: createResp.State}
+	if diags := updateReq.Plan.Set(ctx, plan); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	updateResp := fwresource.UpdateResponse{State: createResp.State}
+	r.Update(ctx, updateReq, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("error updating migration: %v", updateResp.Diagnostics)
+	}
+	expected = append(expected, "CREATE TABLE comments (id INTEGER PRIMARY KEY)")
+	if !slices.Equal(db.statements, expected) {
+		t.Errorf("expected migrations %v to be applied in order, got %v", expected, db.statements)
+	}
+
+	// Read only reports the configured migrations.
+	db.tracked = append(db.tracked, [2]string{"0000_other", "checksum"})
+	readResp := fwresource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: updateResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("error reading migration: %v", readResp.Diagnostics)
+	}
+	if diags := readResp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("error decoding state: %v", diags)
+	}
+	if got := decodeStringList(state.AppliedMigrations); !slices.Equal(got, []string{"0001_users", "0002_posts", "0003_comments"}) {
+		t.Errorf("expected configured migrations to be read, got %v", got)
+	}
+	if calls := fake.callsTo("CreateDatabaseToken"); calls[len(calls)-1] != "CreateDatabaseToken app read-only 10m" {
+		t.Errorf("expected a read-only token valid for the read timeout and the reuse window, got %v", calls)
+	}
+}
+
+func TestDatabaseMigrationResource_RollsBackFailedMigration(t *testing.T) {
+	ctx := context.Background()
+	r, _, db := startMigrationTest(t, ctx)
+
+	dir := t.TempDir()
+	users := writeMigration(t, dir, "0001_users.sql", "CREATE TABLE users (id INTEGER PRIMARY KEY);")
+	posts := writeMigration(t, dir, "0002_posts.sql", "CREATE TABLE posts (id INTEGER PRIMARY KEY);\nINSERT INTO missing VALUES (1);")
+	comments := writeMigration(t, dir, "0003_comments.sql", "CREATE TABLE comments (id INTEGER PRIMARY KEY);")
+	db.failOn["INSERT INTO missing VALUES (1)"] = true
+
+	resp := createMigration(t, ctx, r, testMigrationModel("app", users, posts, comments))
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error applying migrations")
+	}
+
+	if !slices.Equal(db.statements, []string{"CREATE TABLE users (id INTEGER PRIMARY KEY)"}) {
+		t.Errorf("expected only the first migration to be applied, got %v", db.statements)
+	}
+	if len(db.tracked) != 1 || db.tracked[0][0] != "0001_users" {
+		t.Errorf("expected only the first migration to be tracked, got %v", db.tracked)
+	}
+
+	var state resource_database_migration.DatabaseMigrationModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("error decoding state: %v", diags)
+	}
+	if got := decodeStringList(state.AppliedMigrations); !slices.Equal(got, []string{"0001_users"}) {
+		t.Errorf("expected state to record the applied migration, got %v", got)
+	}
+}
+
+func TestDatabaseMigrationResourceRead_ReusesTokens(t *testing.T) {
+	ctx := context.Background()
+	r, fake, _ := startMigrationTest(t, ctx)
+
+	dir := t.TempDir()
+	users := writeMigration(t, dir, "0001_users.sql", "CREATE TABLE users (id INTEGER PRIMARY KEY);")
+	createResp := createMigration(t, ctx, r, testMigrationModel("app", users))
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("error creating migration: %v", createResp.Diagnostics)
+	}
+	read := func() {
+		t.Helper()
+		readResp := fwresource.ReadResponse{State: createResp.State}
+		r.Read(ctx, fwresource.ReadRequest{State: createResp.State}, &readResp)
+		if readResp.Diagnostics.HasError() {
+			t.Fatalf("error reading migration: %v", readResp.Diagnostics)
+		}
+	}
+
+	// Every migration resource of the database is read with the same token.
+	read()
+	read()
+	if calls := fake.callsTo("CreateDatabaseToken"); !slices.Equal(calls, []string{
+		"CreateDatabaseToken app full-access 25m",
+		"CreateDatabaseToken app read-only 10m",
+	}) {
+		t.Errorf("expected one read-only token for both reads, got %v", calls)
+	}
+
+	// Invalidated tokens are not reused.
+	if err := r.invalidateDatabaseTokens(ctx, "app"); err != nil {
+		t.Fatalf("error invalidating tokens: %v", err)
+	}
+	read()
+	if calls := fake.callsTo("CreateDatabaseToken"); len(calls) != 3 || calls[2] != "CreateDatabaseToken app read-only 10m" {
+		t.Errorf("expected a new read-only token after invalidating tokens, got %v", calls)
+	}
+}
+
+func TestDatabaseMigrationResourceRead_RemovesMissingDatabase(t *testing.T) {
+	ctx := context.Background()
+	r, fake, _ := startMigrationTest(t, ctx)
+
+	dir := t.TempDir()
+	users := writeMigration(t, dir, "0001_users.sql", "CREATE TABLE users (id INTEGER PRIMARY KEY);")
+	createResp := createMigration(t, ctx, r, testMigrationModel("app", users))
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("error creating migration: %v", createResp.Diagnostics)
+	}
+
+	fake.mu.Lock()
+	delete(fake.databases, "app")
+	fake.mu.Unlock()
+
+	readResp := fwresource.ReadResponse{State: createResp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("error reading migration: %v", readResp.Diagnostics)
+	}
+	if !readResp.State.Raw.IsNull() {
+		t.Errorf("expected the migrations of the deleted database to be removed from state")
+	}
+}
+
+func TestDatabaseMigrationResourceModifyPlan_RejectsChangedMigrations(t *testing.T) {
+	ctx := context.Background()
+	r := &DatabaseMigrationResource{}
+	s := testMigrationSchema(t, ctx, r)
+
+	dir := t.TempDir()
+	users := writeMigration(t, dir, "0001_users.sql", "CREATE TABLE users (id INTEGER PRIMARY KEY);")
+	migrations, err := loadMigrations([]string{users})
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := testMigrationModel("app", users)
+	state.Id = types.StringValue("app")
+	state.AppliedMigrations, state.Checksums = encodeMigrations(migrations, nil)
+
+	tests := []struct {
+		name    string
+		files   func(t *testing.T) []string
+		applied []string
+		err     string
+	}{
+		{
+			name: "appended",
+			files: func(t *testing.T) []string {
+				return []string{users, writeMigration(t, t.TempDir(), "0002_posts.sql", "CREATE TABLE posts (id);")}
+			},
+			applied: []string{"0001_users", "0002_posts"},
+		},
+		{
+			name: "changed",
+			files: func(t *testing.T) []string {
+				return []string{writeMigration(t, t.TempDir(), "0001_users.sql", "CREATE TABLE users (id, name);")}
+			},
+			err: "migration 0001_users was changed after it was applied",
+		},
+		{
+			name: "inserted",
+			files: func(t *testing.T) []string {
+				return []string{writeMigration(t, t.TempDir(), "0000_setup.sql", "PRAGMA foreign_keys = ON;"), users}
+			},
+			err: "earlier migration 0000_setup was not",
+		},
+		{
+			name: "duplicate",
+			files: func(t *testing.T) []string {
+				return []string{users, writeMigration(t, t.TempDir(), "0001_users.sql", "CREATE TABLE users (id);")}
+			},
+			err: `have the same ID "0001_users"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := state
+			plan.MigrationFiles = encodeStringList(tt.files(t))
+			plan.AppliedMigrations = types.ListUnknown(types.StringType)
+			plan.Checksums = types.MapUnknown(types.StringType)
+
+			req := fwresource.ModifyPlanRequest{Plan: tfsdk.Plan{Schema: s}, State: tfsdk.State{Schema: s}}
+			if diags := req.Plan.Set(ctx, plan); diags.HasError() {
+				t.Fatalf("error encoding plan: %v", diags)
+			}
+			if diags := req.State.Set(ctx, state); diags.HasError() {
+				t.Fatalf("error encoding state: %v", diags)
+			}
+			resp := fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, &resp)
+
+			if tt.err != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tt.err) {
+					t.Errorf("expected error containing %q, got %v", tt.err, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var planned resource_database_migration.DatabaseMigrationModel
+			if diags := resp.Plan.Get(ctx, &planned); diags.HasError() {
+				t.Fatalf("error decoding plan: %v", diags)
+			}
+			if got := decodeStringList(planned.AppliedMigrations); !slices.Equal(got, tt.applied) {
+				t.Errorf("expected planned migrations %v, got %v", tt.applied, got)
+			}
+		})
+	}
+}
+
+func TestDatabaseMigrationResource_ImportState(t *testing.T) {
+	ctx := context.Background()
+	r, _, db := startMigrationTest(t, ctx)
+	s := testMigrationSchema(t, ctx, r)
+	db.tables["migrations"] = true
+	db.tracked = [][2]string{{"0002_posts", "b"}, {"0001_users", "a"}}
+
+	importResp := fwresource.ImportStateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: "test-org/app:migrations"}, &importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("error importing migrations: %v", importResp.Diagnostics)
+	}
+	readResp := fwresource.ReadResponse{State: importResp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: importResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("error reading migrations: %v", readResp.Diagnostics)
+	}
+
+	var state resource_database_migration.DatabaseMigrationModel
+	if diags := readResp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("error decoding state: %v", diags)
+	}
+	if state.Database.ValueString() != "app" || state.TrackingTable.ValueString() != "migrations" {
+		t.Errorf("expected database and tracking table from the import ID, got %v and %v", state.Database, state.TrackingTable)
+	}
+	if got := decodeStringList(state.AppliedMigrations); !slices.Equal(got, []string{"0001_users", "0002_posts"}) {
+		t.Errorf("expected all applied migrations to be tracked in order, got %v", got)
+	}
+}
+
+func TestDatabaseMigrationResource_ImportStateByIdentity(t *testing.T) {
+	ctx := context.Background()
+	r, _, db := startMigrationTest(t, ctx)
+	s := testMigrationSchema(t, ctx, r)
+	db.tables[defaultTrackingTable] = true
+	db.tracked = [][2]string{{"0001_users", "a"}}
+
+	var identityResp fwresource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identityResp)
+	is := identityResp.IdentitySchema
+	identity := &tfsdk.ResourceIdentity{Schema: is, Raw: tftypes.NewValue(is.Type().TerraformType(ctx), nil)}
+	if diags := identity.Set(ctx, databaseMigrationIdentityModel{
+		Organization:  types.StringNull(),
+		Database:      types.StringValue("app"),
+		TrackingTable: types.StringNull(),
+	}); diags.HasError() {
+		t.Fatalf("error encoding identity: %v", diags)
+	}
+
+	importResp := fwresource.ImportStateResponse{
+		State:    tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+		Identity: &tfsdk.ResourceIdentity{Schema: is, Raw: identity.Raw.Copy()},
+	}
+	r.ImportState(ctx, fwresource.ImportStateRequest{Identity: identity}, &importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("error importing migrations: %v", importResp.Diagnostics)
+	}
+	readResp := fwresource.ReadResponse{State: importResp.State, Identity: importResp.Identity}
+	r.Read(ctx, fwresource.ReadRequest{State: importResp.State, Identity: importResp.Identity}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("error reading migrations: %v", readResp.Diagnostics)
+	}
+
+	var state resource_database_migration.DatabaseMigrationModel
+	if diags := readResp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("error decoding state: %v", diags)
+	}
+	if state.Database.ValueString() != "app" || state.TrackingTable.ValueString() != defaultTrackingTable {
+		t.Errorf("expected database and default tracking table from the identity, got %v and %v", state.Database, state.TrackingTable)
+	}
+	if got := decodeStringList(state.AppliedMigrations); !slices.Equal(got, []string{"0001_users"}) {
+		t.Errorf("expected the applied migrations to be tracked, got %v", got)
+	}
+
+	var got databaseMigrationIdentityModel
+	if diags := readResp.Identity.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("error decoding identity: %v", diags)
+	}
+	want := databaseMigrationIdentityModel{
+		Organization:  types.StringValue("test-org"),
+		Database:      types.StringValue("app"),
+		TrackingTable: types.StringValue(defaultTrackingTable),
+	}
+	if got != want {
+		t.Errorf("expected identity %+v, got %+v", want, got)
+	}
+}
@@ -1,12 +1,32 @@
 package provider
 
 import (
+	"context"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/celest-dev/terraform-provider-turso/internal/resource_database"
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccResourceDatabase(t *testing.T) {
@@ -28,6 +48,8 @@ func TestAccResourceDatabase(t *testing.T) {
 					statecheck.ExpectKnownValue("turso_database.test", tfjsonpath.New("group"), knownvalue.StringExact("test")),
 					statecheck.ExpectKnownValue("turso_database.test", tfjsonpath.New("database"), knownvalue.NotNull()),
 					statecheck.ExpectKnownValue("turso_database.test", tfjsonpath.New("allow_attach"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("turso_database.test", tfjsonpath.New("database").AtMapKey("libsql_url"), knownvalue.StringRegexp(regexp.MustCompile(`^libsql://`+name+`-`))),
+					statecheck.ExpectKnownValue("turso_database.test", tfjsonpath.New("database").AtMapKey("http_url"), knownvalue.StringRegexp(regexp.MustCompile(`^https://`+name+`-`))),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("turso_database.test", "name", name),
@@ -46,6 +68,39 @@ func TestAccResourceDatabase(t *testing.T) {
 	})
 }
 
+func TestAccResourceDatabaseIdentity(t *testing.T) {
+	name := randomName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_database" "test" {
+					group = "test"
+					name = "` + name + `"
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("turso_database.test", map[string]knownvalue.Check{
+						"organization": knownvalue.StringExact("celest-dev"),
+						"name":         knownvalue.StringExact(name),
+					}),
+				},
+			},
+
+			// Import with an import block by identity
+			{
+				ResourceName:    "turso_database.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func TestAccResourceDatabaseWithConfig(t *testing.T) {
 	name := randomName()
 	resource.Test(t, resource.TestCase{
@@ -80,6 +135,1555 @@ func TestAccResourceDatabaseWithConfig(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+
+			// ImportState with an organization-qualified ID
+			{
+				ResourceName:      "turso_database.test",
+				ImportStateId:     "celest-dev/" + name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceDatabaseWaitForReady(t *testing.T) {
+	name := randomName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_database" "test" {
+					group = "test"
+					name = "` + name + `"
+					wait_for_ready = true
+
+					timeouts {
+						create = "5m"
+					}
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("turso_database.test", tfjsonpath.New("wait_for_ready"), knownvalue.Bool(true)),
+				},
+			},
+
+			// Turning off the wait is a provider-only change
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_database" "test" {
+					group = "test"
+					name = "` + name + `"
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("turso_database.test", tfjsonpath.New("wait_for_ready"), knownvalue.Bool(false)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceDatabaseBranch(t *testing.T) {
+	name := randomName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_database" "branch" {
+					group = "test"
+					name = "` + name + `-branch"
+					seed = {
+						database = "source"
+						timestamp = "yesterday"
+					}
+				}`),
+				ExpectError: regexp.MustCompile(`Invalid seed timestamp`),
+			},
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_database" "source" {
+					group = "test"
+					name = "` + name + `"
+				}
+
+				resource "turso_database" "branch" {
+					group = "test"
+					name = "` + name + `-branch"
+					seed = {
+						database = turso_database.source.name
+					}
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("turso_database.branch", tfjsonpath.New("seed").AtMapKey("type"), knownvalue.StringExact("database")),
+					statecheck.ExpectKnownValue("turso_database.branch", tfjsonpath.New("seeded_from").AtMapKey("database"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue("turso_database.branch", tfjsonpath.New("seeded_from").AtMapKey("timestamp"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceDatabaseSchema(t *testing.T) {
+	name := randomName()
+	config := testAccCreateConfig(`
+	resource "turso_database" "parent" {
+		group = "test"
+		name = "` + name + `"
+		is_schema = true
+	}
+
+	resource "turso_database" "child" {
+		group = "test"
+		name = "` + name + `-child"
+		schema = turso_database.parent.name
+	}`)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("turso_database.child", tfjsonpath.New("schema"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue("turso_database.child", tfjsonpath.New("children"), knownvalue.Null()),
+				},
+			},
+
+			// The parent lists the child once refreshed
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("turso_database.parent", tfjsonpath.New("children"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact(name + "-child"),
+					})),
+				},
+			},
+
+			// Only schema databases can be used as a schema
+			{
+				Config: config + `
+				resource "turso_database" "invalid" {
+					group = "test"
+					name = "` + name + `-invalid"
+					schema = turso_database.child.name
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid schema database`),
+			},
+		},
+	})
+}
+
+func TestAccResourceDatabaseSizeLimit(t *testing.T) {
+	name := randomName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_database" "test" {
+					group = "test"
+					name = "` + name + `"
+					size_limit = "1gb"
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("turso_database.test", tfjsonpath.New("size_limit"), knownvalue.StringExact("1gb")),
+				},
+			},
+			// Equivalent sizes are updated without replacing the database.
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_database" "test" {
+					group = "test"
+					name = "` + name + `"
+					size_limit = "1000mb"
+				}`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("turso_database.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("turso_database.test", tfjsonpath.New("database").AtMapKey("db_id"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("turso_database.test", tfjsonpath.New("database").AtMapKey("hostname"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("turso_database.test", tfjsonpath.New("database").AtMapKey("allow_attach"), knownvalue.Bool(false)),
+						plancheck.ExpectUnknownValue("turso_database.test", tfjsonpath.New("database").AtMapKey("version")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("turso_database.test", tfjsonpath.New("size_limit"), knownvalue.StringExact("1000mb")),
+				},
+			},
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_database" "test" {
+					group = "test"
+					name = "` + name + `"
+					size_limit = "1 gigabyte"
+				}`),
+				ExpectError: regexp.MustCompile(`Invalid size`),
+			},
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_database" "invalid" {
+					group = "test"
+					name = "Invalid_Name"
+				}`),
+				ExpectError: regexp.MustCompile(`must contain only lowercase letters, numbers and dashes`),
+			},
+		},
+	})
+}
+
+func TestAccResourceDatabaseMove(t *testing.T) {
+	name := randomName()
+	group := randomName()
+	config := func(databaseGroup string) string {
+		return testAccCreateConfig(`
+		resource "turso_group" "target" {
+			name = "` + group + `"
+			primary = "sjc"
+			locations = ["sjc"]
+		}
+
+		resource "turso_database" "test" {
+			group = ` + databaseGroup + `
+			name = "` + name + `"
+		}`)
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`"test"`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("turso_database.test", tfjsonpath.New("database").AtMapKey("group"), knownvalue.StringExact("test")),
+				},
+			},
+			// Changing the group replaces the database with a copy of it
+			// rather than failing.
+			{
+				Config: config(`turso_group.target.name`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("turso_database.test", plancheck.ResourceActionReplace),
+						plancheck.ExpectUnknownValue("turso_database.test", tfjsonpath.New("database")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("turso_database.test", tfjsonpath.New("group"), knownvalue.StringExact(group)),
+					statecheck.ExpectKnownValue("turso_database.test", tfjsonpath.New("database").AtMapKey("group"), knownvalue.StringExact(group)),
+				},
+			},
 		},
 	})
 }
+
+func TestAccResourceDatabaseDeletionProtection(t *testing.T) {
+	name := randomName()
+	config := func(deletionProtection string) string {
+		return testAccCreateConfig(`
+		resource "turso_database" "test" {
+			group = "test"
+			name = "` + name + `"
+			deletion_protection = ` + deletionProtection + `
+		}`)
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("true"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("turso_database.test", tfjsonpath.New("deletion_protection"), knownvalue.Bool(true)),
+				},
+			},
+			{
+				Config:      config("true"),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`Deletion protection enabled`),
+			},
+			// Turning protection off in an apply allows the database to be
+			// destroyed at the end of the test.
+			{
+				Config: config("false"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("turso_database.test", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testDatabaseModel(name, group string) resource_database.DatabaseModel {
+	return resource_database.DatabaseModel{
+		Children:           types.ListUnknown(types.StringType),
+		Database:           resource_database.NewDatabaseValueUnknown(),
+		Group:              types.StringValue(group),
+		Id:                 types.StringUnknown(),
+		IsSchema:           types.BoolUnknown(),
+		Name:               types.StringValue(name),
+		Schema:             types.StringUnknown(),
+		Seed:               resource_database.NewSeedValueUnknown(),
+		SeededFrom:         resource_database.NewSeededFromValueUnknown(),
+		AllowAttach:        types.BoolUnknown(),
+		BlockReads:         types.BoolUnknown(),
+		BlockWrites:        types.BoolUnknown(),
+		SizeLimit:          types.StringUnknown(),
+		DeletionProtection: types.BoolValue(false),
+		FinalSnapshot:      types.StringNull(),
+		WaitForReady:       types.BoolValue(false),
+		Timeouts:           testTimeoutsNull(),
+	}
+}
+
+// testSeed returns a seed with the given attributes set. Other computed
+// attributes are set to unset, which is null in configuration and unknown in
+// a plan, and the others to null.
+func testSeed(unset types.String, set map[string]string) resource_database.SeedValue {
+	attributes := map[string]attr.Value{}
+	for name := range resource_database.NewSeedValueNull().AttributeTypes(context.Background()) {
+		attributes[name] = unset
+		if name == "database" || name == "dump_file" {
+			attributes[name] = types.StringNull()
+		}
+		if v, ok := set[name]; ok {
+			attributes[name] = types.StringValue(v)
+		}
+	}
+	return resource_database.NewSeedValueMust(resource_database.SeedValue{}.AttributeTypes(context.Background()), attributes)
+}
+
+func testDatabaseSchema(t *testing.T, ctx context.Context, r *DatabaseResource) schema.Schema {
+	t.Helper()
+
+	var resp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("error building schema: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+func writeDumpFile(t *testing.T, contents string) string {
+	t.Helper()
+
+	name := filepath.Join(t.TempDir(), "seed.sql")
+	if err := os.WriteFile(name, []byte(contents), 0o644); err != nil {
+		t.Fatalf("error writing dump file: %v", err)
+	}
+	return name
+}
+
+func TestDatabaseResourceCreate_UploadsDumpFile(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &DatabaseResource{tursoProviderConfig: fake.start(t)}
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: r.tursoProviderConfig}, "test", "sjc", "sjc")
+	s := testDatabaseSchema(t, ctx, r)
+
+	const dump = "CREATE TABLE t (id INTEGER PRIMARY KEY);\n"
+	dumpFile := writeDumpFile(t, dump)
+	data := testDatabaseModel("db", "test")
+	data.Seed = testSeed(types.StringUnknown(), map[string]string{"dump_file": dumpFile})
+
+	req := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	if diags := req.Plan.Set(ctx, data); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("error creating database: %v", resp.Diagnostics)
+	}
+
+	seed := fake.created["db"].Seed.Value
+	if got := seed.Type.Value; got != "dump" {
+		t.Errorf("expected dump seed type, got %q", got)
+	}
+	if got := fake.dumps[seed.URL.Value]; got != dump {
+		t.Errorf("expected database to be seeded from uploaded dump, got %q", got)
+	}
+
+	var state resource_database.DatabaseModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("error decoding state: %v", diags)
+	}
+	_, hash, err := readDumpFile(dumpFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := state.Seed.DumpFileHash.ValueString(); got != hash {
+		t.Errorf("expected dump_file_hash %q, got %q", hash, got)
+	}
+	if got := state.Seed.Url.ValueString(); got != seed.URL.Value {
+		t.Errorf("expected url %q, got %q", seed.URL.Value, got)
+	}
+	if !state.Seed.Name.IsNull() || !state.Seed.Timestamp.IsNull() {
+		t.Errorf("expected unset seed attributes to be null, got %v", state.Seed)
+	}
+}
+
+func TestDumpFileHashModifier(t *testing.T) {
+	ctx := context.Background()
+	r := &DatabaseResource{}
+	s := testDatabaseSchema(t, ctx, r)
+
+	dumpFile := writeDumpFile(t, "CREATE TABLE t (id INTEGER PRIMARY KEY);\n")
+	_, hash, err := readDumpFile(dumpFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name            string
+		state           types.String
+		requiresReplace bool
+	}{
+		{name: "create", state: types.StringNull()},
+		{name: "unchanged", state: types.StringValue(hash)},
+		{name: "changed", state: types.StringValue("old"), requiresReplace: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testDatabaseModel("db", "test")
+			data.Seed = testSeed(types.StringUnknown(), map[string]string{"dump_file": dumpFile})
+			plan := tfsdk.Plan{Schema: s}
+			if diags := plan.Set(ctx, data); diags.HasError() {
+				t.Fatalf("error encoding plan: %v", diags)
+			}
+
+			req := planmodifier.StringRequest{
+				Path:       path.Root("seed").AtName("dump_file_hash"),
+				Plan:       plan,
+				PlanValue:  types.StringUnknown(),
+				StateValue: tt.state,
+			}
+			var resp planmodifier.StringResponse
+			dumpFileHashModifier{}.PlanModifyString(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if got := resp.PlanValue.ValueString(); got != hash {
+				t.Errorf("expected planned hash %q, got %q", hash, got)
+			}
+			if resp.RequiresReplace != tt.requiresReplace {
+				t.Errorf("expected RequiresReplace %v, got %v", tt.requiresReplace, resp.RequiresReplace)
+			}
+		})
+	}
+}
+
+func TestDumpFileHashModifier_MissingFile(t *testing.T) {
+	ctx := context.Background()
+	r := &DatabaseResource{}
+	s := testDatabaseSchema(t, ctx, r)
+
+	data := testDatabaseModel("db", "test")
+	data.Seed = testSeed(types.StringUnknown(), map[string]string{"dump_file": filepath.Join(t.TempDir(), "missing.sql")})
+	plan := tfsdk.Plan{Schema: s}
+	if diags := plan.Set(ctx, data); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	modify := func(state types.String) planmodifier.StringResponse {
+		req := planmodifier.StringRequest{
+			Path:       path.Root("seed").AtName("dump_file_hash"),
+			Plan:       plan,
+			PlanValue:  types.StringUnknown(),
+			StateValue: state,
+		}
+		var resp planmodifier.StringResponse
+		dumpFileHashModifier{}.PlanModifyString(ctx, req, &resp)
+		return resp
+	}
+
+	// The dump is needed to seed a new database.
+	if resp := modify(types.StringNull()); !resp.Diagnostics.HasError() {
+		t.Errorf("expected an error creating a database from a missing dump file")
+	}
+
+	// An existing database keeps the hash it was seeded with.
+	resp := modify(types.StringValue("seeded"))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("expected a warning about the missing dump file, got %v", resp.Diagnostics)
+	}
+	if got := resp.PlanValue.ValueString(); got != "seeded" {
+		t.Errorf("expected the prior hash to be planned, got %q", got)
+	}
+	if resp.RequiresReplace {
+		t.Errorf("expected no replacement")
+	}
+}
+
+func TestValidateSeedTimestamp(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		timestamp string
+		valid     bool
+	}{
+		{timestamp: "2024-06-01T11:00:00Z", valid: true},
+		{timestamp: "2024-06-01T13:00:00+02:00", valid: true},
+		{timestamp: "2024-06-01T13:00:00Z"},
+		{timestamp: "2024-06-01 11:00:00"},
+		{timestamp: "2024-06-01"},
+		{timestamp: "yesterday"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.timestamp, func(t *testing.T) {
+			err := validateSeedTimestamp(tt.timestamp, now)
+			if tt.valid && err != nil {
+				t.Errorf("expected valid timestamp, got error: %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Errorf("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestDatabaseResourceCreate_BranchesFromDatabase(t *testing.T) {
+	// The source database is set in database, or in name by configurations
+	// written before database was added.
+	for _, attribute := range []string{"database", "name"} {
+		t.Run(attribute, func(t *testing.T) {
+			testDatabaseResourceCreateBranchesFromDatabase(t, attribute)
+		})
+	}
+}
+
+func testDatabaseResourceCreateBranchesFromDatabase(t *testing.T, attribute string) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &DatabaseResource{tursoProviderConfig: fake.start(t)}
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: r.tursoProviderConfig}, "test", "sjc", "sjc")
+	fake.addDatabase("main", "test", "main-test-org.turso.io")
+	s := testDatabaseSchema(t, ctx, r)
+
+	const timestamp = "2024-06-01T11:00:00Z"
+	data := testDatabaseModel("preview", "test")
+	data.Seed = testSeed(types.StringUnknown(), map[string]string{attribute: "main", "timestamp": timestamp})
+
+	req := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	if diags := req.Plan.Set(ctx, data); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("error creating database: %v", resp.Diagnostics)
+	}
+
+	seed := fake.created["preview"].Seed.Value
+	if seed.Type.Value != "database" || seed.Name.Value != "main" || seed.Timestamp.Value != timestamp {
+		t.Errorf("expected database seed from main at %s, got %+v", timestamp, seed)
+	}
+
+	var state resource_database.DatabaseModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("error decoding state: %v", diags)
+	}
+	if got := state.Seed.SeedType.ValueString(); got != "database" {
+		t.Errorf("expected seed type database, got %q", got)
+	}
+	seededFrom := state.SeededFrom
+	if seededFrom.Database.ValueString() != "main" || seededFrom.DatabaseId.ValueString() != "id-main" || seededFrom.Timestamp.ValueString() != timestamp {
+		t.Errorf("unexpected seeded_from: %+v", seededFrom)
+	}
+
+	// Moving the source database between database and name updates the
+	// seed in place.
+	other := "name"
+	if attribute == "name" {
+		other = "database"
+	}
+	plan := state
+	plan.Seed = testSeed(types.StringNull(), map[string]string{other: "main", "timestamp": timestamp, "type": "database"})
+	if other == "database" {
+		plan.Seed.Name = state.Seed.Name
+	}
+	updateReq := fwresource.UpdateRequest{Plan: tfsdk.Plan{Schema: s}, State: resp.State}
+	if diags := updateReq.Plan.Set(ctx, plan); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	updateResp := fwresource.UpdateResponse{State: resp.State}
+	r.Update(ctx, updateReq, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("error updating seed: %v", updateResp.Diagnostics)
+	}
+	if diags := updateResp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("error decoding state: %v", diags)
+	}
+	if !state.Seed.Equal(plan.Seed) {
+		t.Errorf("expected the planned seed to be saved, got %+v", state.Seed)
+	}
+}
+
+func TestDatabaseResourceModifyPlan_ValidatesSeedDatabase(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &DatabaseResource{tursoProviderConfig: fake.start(t)}
+	groups := &GroupResource{tursoProviderConfig: r.tursoProviderConfig}
+	createGroup(t, ctx, groups, "test", "sjc", "sjc")
+	createGroup(t, ctx, groups, "other", "sjc", "sjc")
+	fake.addDatabase("main", "test", "main-test-org.turso.io")
+	s := testDatabaseSchema(t, ctx, r)
+
+	tests := []struct {
+		name   string
+		group  string
+		source string
+		err    *regexp.Regexp
+	}{
+		{name: "same group", group: "test", source: "main"},
+		{name: "other group", group: "other", source: "main", err: regexp.MustCompile(`same group`)},
+		// The source may be created in the same apply, so it is checked
+		// when the database is created.
+		{name: "missing", group: "test", source: "missing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := testDatabaseModel("preview", tt.group)
+			config.Seed = testSeed(types.StringNull(), map[string]string{"name": tt.source})
+			plan := testDatabaseModel("preview", tt.group)
+			plan.Seed = testSeed(types.StringUnknown(), map[string]string{"name": tt.source})
+
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s},
+				Plan:   tfsdk.Plan{Schema: s},
+				State:  tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+			}
+			configPlan := tfsdk.Plan{Schema: s}
+			if diags := configPlan.Set(ctx, config); diags.HasError() {
+				t.Fatalf("error encoding config: %v", diags)
+			}
+			req.Config.Raw = configPlan.Raw
+			if diags := req.Plan.Set(ctx, plan); diags.HasError() {
+				t.Fatalf("error encoding plan: %v", diags)
+			}
+			resp := fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, &resp)
+
+			if tt.err != nil {
+				if !resp.Diagnostics.HasError() || !tt.err.MatchString(resp.Diagnostics.Errors()[0].Detail()) {
+					t.Fatalf("expected error matching %s, got %v", tt.err, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var seededFrom resource_database.SeededFromValue
+			if diags := resp.Plan.GetAttribute(ctx, path.Root("seeded_from"), &seededFrom); diags.HasError() {
+				t.Fatalf("error decoding plan: %v", diags)
+			}
+			if tt.source == "missing" {
+				if !seededFrom.IsUnknown() {
+					t.Errorf("expected seeded_from to be unknown, got %+v", seededFrom)
+				}
+				return
+			}
+			if seededFrom.DatabaseId.ValueString() != "id-main" || !seededFrom.Timestamp.IsNull() {
+				t.Errorf("unexpected seeded_from: %+v", seededFrom)
+			}
+		})
+	}
+}
+
+func TestDatabaseResourceCreate_SeedDatabaseNotFound(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &DatabaseResource{tursoProviderConfig: fake.start(t)}
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: r.tursoProviderConfig}, "test", "sjc", "sjc")
+	s := testDatabaseSchema(t, ctx, r)
+
+	data := testDatabaseModel("preview", "test")
+	data.Seed = testSeed(types.StringUnknown(), map[string]string{"name": "missing"})
+	req := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	if diags := req.Plan.Set(ctx, data); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, req, &resp)
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "does not exist") {
+		t.Fatalf("expected an error for the missing seed database, got %v", resp.Diagnostics)
+	}
+	if _, ok := fake.created["preview"]; ok {
+		t.Errorf("expected the database not to be created")
+	}
+}
+
+// createDatabase creates a database with the resource and returns its state.
+func createDatabase(t *testing.T, ctx context.Context, r *DatabaseResource, data resource_database.DatabaseModel) tfsdk.State {
+	t.Helper()
+
+	s := testDatabaseSchema(t, ctx, r)
+	req := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	if diags := req.Plan.Set(ctx, data); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("error creating database: %v", resp.Diagnostics)
+	}
+	return resp.State
+}
+
+func TestDatabaseResourceModifyPlan_ValidatesSchemaDatabase(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &DatabaseResource{tursoProviderConfig: fake.start(t)}
+	groups := &GroupResource{tursoProviderConfig: r.tursoProviderConfig}
+	createGroup(t, ctx, groups, "test", "sjc", "sjc")
+	createGroup(t, ctx, groups, "other", "sjc", "sjc")
+	fake.addDatabase("parent", "test", "parent-test-org.turso.io").IsSchema = tursoclient.NewOptBool(true)
+	fake.addDatabase("plain", "test", "plain-test-org.turso.io")
+	s := testDatabaseSchema(t, ctx, r)
+
+	tests := []struct {
+		name   string
+		group  string
+		schema string
+		err    *regexp.Regexp
+	}{
+		{name: "schema database", group: "test", schema: "parent"},
+		{name: "not a schema database", group: "test", schema: "plain", err: regexp.MustCompile(`not a schema database`)},
+		{name: "other group", group: "other", schema: "parent", err: regexp.MustCompile(`same group`)},
+		// The schema database may be created in the same apply, so it is
+		// checked when the database is created.
+		{name: "missing", group: "test", schema: "missing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testDatabaseModel("child", tt.group)
+			data.Schema = types.StringValue(tt.schema)
+			config := data
+			config.Seed = resource_database.NewSeedValueNull()
+
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s},
+				Plan:   tfsdk.Plan{Schema: s},
+				State:  tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+			}
+			configPlan := tfsdk.Plan{Schema: s}
+			if diags := configPlan.Set(ctx, config); diags.HasError() {
+				t.Fatalf("error encoding config: %v", diags)
+			}
+			req.Config.Raw = configPlan.Raw
+			if diags := req.Plan.Set(ctx, data); diags.HasError() {
+				t.Fatalf("error encoding plan: %v", diags)
+			}
+			resp := fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, &resp)
+
+			if tt.err == nil {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if !resp.Diagnostics.HasError() || !tt.err.MatchString(resp.Diagnostics.Errors()[0].Detail()) {
+				t.Fatalf("expected error matching %s, got %v", tt.err, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDatabaseResourceCreate_SchemaDatabaseNotFound(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &DatabaseResource{tursoProviderConfig: fake.start(t)}
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: r.tursoProviderConfig}, "test", "sjc", "sjc")
+	s := testDatabaseSchema(t, ctx, r)
+
+	data := testDatabaseModel("child", "test")
+	data.Schema = types.StringValue("missing")
+	req := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	if diags := req.Plan.Set(ctx, data); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, req, &resp)
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "does not exist") {
+		t.Fatalf("expected an error for the missing schema database, got %v", resp.Diagnostics)
+	}
+	if _, ok := fake.created["child"]; ok {
+		t.Errorf("expected the database not to be created")
+	}
+}
+
+func TestDatabaseResource_SchemaChildren(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &DatabaseResource{tursoProviderConfig: fake.start(t)}
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: r.tursoProviderConfig}, "test", "sjc", "sjc")
+	s := testDatabaseSchema(t, ctx, r)
+
+	parent := testDatabaseModel("parent", "test")
+	parent.IsSchema = types.BoolValue(true)
+	parentState := createDatabase(t, ctx, r, parent)
+	for _, name := range []string{"child-b", "child-a"} {
+		child := testDatabaseModel(name, "test")
+		child.Schema = types.StringValue("parent")
+		createDatabase(t, ctx, r, child)
+	}
+
+	readResp := fwresource.ReadResponse{State: parentState}
+	r.Read(ctx, fwresource.ReadRequest{State: parentState}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("error reading database: %v", readResp.Diagnostics)
+	}
+	var state resource_database.DatabaseModel
+	if diags := readResp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("error decoding state: %v", diags)
+	}
+	if got := decodeStringList(state.Children); !slices.Equal(got, []string{"child-a", "child-b"}) {
+		t.Errorf("expected children [child-a child-b], got %v", got)
+	}
+
+	deleteResp := fwresource.DeleteResponse{State: tfsdk.State{Schema: s, Raw: readResp.State.Raw}}
+	r.Delete(ctx, fwresource.DeleteRequest{State: readResp.State}, &deleteResp)
+	if !deleteResp.Diagnostics.HasError() {
+		t.Fatalf("expected deleting a schema database with children to fail")
+	}
+	if _, ok := fake.databases["parent"]; !ok {
+		t.Errorf("expected schema database not to be deleted")
+	}
+
+	delete(fake.databases, "child-a")
+	delete(fake.databases, "child-b")
+	deleteResp = fwresource.DeleteResponse{State: tfsdk.State{Schema: s, Raw: readResp.State.Raw}}
+	r.Delete(ctx, fwresource.DeleteRequest{State: readResp.State}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("error deleting database: %v", deleteResp.Diagnostics)
+	}
+	if _, ok := fake.databases["parent"]; ok {
+		t.Errorf("expected schema database to be deleted")
+	}
+}
+
+func TestDatabaseResource_SizeLimit(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &DatabaseResource{tursoProviderConfig: fake.start(t)}
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: r.tursoProviderConfig}, "test", "sjc", "sjc")
+	s := testDatabaseSchema(t, ctx, r)
+
+	data := testDatabaseModel("db", "test")
+	data.SizeLimit = types.StringValue("256mb")
+	req := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	if diags := req.Plan.Set(ctx, data); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("error creating database: %v", resp.Diagnostics)
+	}
+	if got := fake.created["db"].SizeLimit.Value; got != "256000000" {
+		t.Errorf("expected size limit to be sent in bytes, got %q", got)
+	}
+
+	update := func(sizeLimit string) fwresource.UpdateResponse {
+		var plan resource_database.DatabaseModel
+		if diags := resp.State.Get(ctx, &plan); diags.HasError() {
+			t.Fatalf("error decoding state: %v", diags)
+		}
+		plan.SizeLimit = types.StringValue(sizeLimit)
+		updateReq := fwresource.UpdateRequest{Plan: tfsdk.Plan{Schema: s}, State: resp.State}
+		if diags := updateReq.Plan.Set(ctx, plan); diags.HasError() {
+			t.Fatalf("error encoding plan: %v", diags)
+		}
+		updateResp := fwresource.UpdateResponse{State: tfsdk.State{Schema: s, Raw: updateReq.Plan.Raw.Copy()}}
+		r.Update(ctx, updateReq, &updateResp)
+		return updateResp
+	}
+
+	// The same size written differently only changes the state.
+	calls := fake.callsTo("UpdateDatabaseConfiguration")
+	updateResp := update("256000KB")
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("error updating database: %v", updateResp.Diagnostics)
+	}
+	var state resource_database.DatabaseModel
+	if diags := updateResp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("error decoding state: %v", diags)
+	}
+	if got := state.SizeLimit.ValueString(); got != "256000KB" {
+		t.Errorf("expected size_limit to be updated in state, got %q", got)
+	}
+	if got := fake.callsTo("UpdateDatabaseConfiguration"); !slices.Equal(got, calls) {
+		t.Errorf("expected no API calls, got %v", got[len(calls):])
+	}
+
+	if updateResp := update("512mb"); !updateResp.Diagnostics.HasError() {
+		t.Errorf("expected changing the size limit to fail")
+	}
+}
+
+// testPlanDatabaseMove plans moving the database in state to group new as
+// Terraform does, by planning the update and then, if it requires replacing
+// the database, the database which replaces it. It returns the responses of
+// the plans it ran.
+func testPlanDatabaseMove(t *testing.T, ctx context.Context, r *DatabaseResource, state resource_database.DatabaseModel) (update, create fwresource.ModifyPlanResponse) {
+	t.Helper()
+
+	s := testDatabaseSchema(t, ctx, r)
+	private := newPrivateState(fwresource.ModifyPlanResponse{}.Private)
+	modifyPlan := func(plan resource_database.DatabaseModel, state *resource_database.DatabaseModel) fwresource.ModifyPlanResponse {
+		req := fwresource.ModifyPlanRequest{
+			Plan:    tfsdk.Plan{Schema: s},
+			State:   tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+			Private: private,
+		}
+		if diags := req.Plan.Set(ctx, plan); diags.HasError() {
+			t.Fatalf("error encoding plan: %v", diags)
+		}
+		if state != nil {
+			if diags := req.State.Set(ctx, state); diags.HasError() {
+				t.Fatalf("error encoding state: %v", diags)
+			}
+		}
+		req.Config = tfsdk.Config{Schema: s, Raw: req.Plan.Raw}
+		resp := fwresource.ModifyPlanResponse{Plan: req.Plan, Private: private}
+		r.ModifyPlan(ctx, req, &resp)
+		return resp
+	}
+
+	planned := state
+	planned.Group = types.StringValue("new")
+	update = modifyPlan(planned, &state)
+	if update.Diagnostics.HasError() || !slices.ContainsFunc(update.RequiresReplace, path.Root("group").Equal) {
+		return update, create
+	}
+
+	planned = testDatabaseModel(state.Name.ValueString(), "new")
+	planned.DeletionProtection = state.DeletionProtection
+	planned.FinalSnapshot = state.FinalSnapshot
+	if isProvided(state.Seed) {
+		planned.Seed = testSeed(types.StringUnknown(), map[string]string{"name": state.Seed.Name.ValueString(), "type": state.Seed.SeedType.ValueString()})
+	}
+	return update, modifyPlan(planned, nil)
+}
+
+// testMoveDatabase plans moving the database in state to group new with
+// testPlanDatabaseMove, and applies the replacement by deleting the database
+// in state and creating the planned one. It returns the response of the
+// create, or fails the test if an earlier step failed.
+func testMoveDatabase(t *testing.T, ctx context.Context, r *DatabaseResource, state resource_database.DatabaseModel) fwresource.CreateResponse {
+	t.Helper()
+
+	update, planResp := testPlanDatabaseMove(t, ctx, r, state)
+	if update.Diagnostics.HasError() || planResp.Diagnostics.HasError() {
+		t.Fatalf("error planning move: %v %v", update.Diagnostics, planResp.Diagnostics)
+	}
+	s := testDatabaseSchema(t, ctx, r)
+	private := planResp.Private
+	deleteReq := fwresource.DeleteRequest{State: tfsdk.State{Schema: s}, Private: private}
+	if diags := deleteReq.State.Set(ctx, state); diags.HasError() {
+		t.Fatalf("error encoding state: %v", diags)
+	}
+	deleteResp := fwresource.DeleteResponse{State: deleteReq.State, Private: private}
+	r.Delete(ctx, deleteReq, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("error deleting database: %v", deleteResp.Diagnostics)
+	}
+
+	createResp := fwresource.CreateResponse{
+		State:   tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+		Private: private,
+	}
+	r.Create(ctx, fwresource.CreateRequest{Plan: planResp.Plan}, &createResp)
+	return createResp
+}
+
+func TestDatabaseResource_MovesGroup(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &DatabaseResource{tursoProviderConfig: fake.start(t)}
+	fake.serveDatabases(t, r.tursoProviderConfig)
+	groups := &GroupResource{tursoProviderConfig: r.tursoProviderConfig}
+	createGroup(t, ctx, groups, "old", "sjc", "sjc")
+	createGroup(t, ctx, groups, "new", "iad", "iad")
+	fake.addDatabase("source", "old", "source-test-org.turso.io")
+	fake.addDatabase("db", "old", "db-test-org.turso.io")
+	rows := map[string]int64{"posts": 12, "users": 3}
+	fake.rows["db"] = maps.Clone(rows)
+
+	curr := testDatabaseModel("db", "old")
+	if diags := r.readDatabaseResource(ctx, "db", &curr); diags.HasError() {
+		t.Fatalf("error reading database: %v", diags)
+	}
+	curr.Seed = testSeed(types.StringNull(), map[string]string{"name": "source", "type": seedTypeDatabase})
+	curr.SeededFrom = resource_database.NewSeededFromValueMust(resource_database.SeededFromValue{}.AttributeTypes(ctx), map[string]attr.Value{
+		"database":    types.StringValue("source"),
+		"database_id": types.StringValue("source-id"),
+		"timestamp":   types.StringNull(),
+	})
+	stateOf := func(resp fwresource.CreateResponse) resource_database.DatabaseModel {
+		t.Helper()
+		var state resource_database.DatabaseModel
+		if diags := resp.State.Get(ctx, &state); diags.HasError() {
+			t.Fatalf("error decoding state: %v", diags)
+		}
+		return state
+	}
+
+	snapshotPath := filepath.Join(t.TempDir(), "db.sql")
+	withSnapshot := curr
+	withSnapshot.FinalSnapshot = types.StringValue(snapshotPath)
+	resp := testMoveDatabase(t, ctx, r, withSnapshot)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("error moving database: %v", resp.Diagnostics)
+	}
+	state := stateOf(resp)
+	if calls := fake.callsTo("DeleteDatabase"); !slices.Equal(calls, []string{"DeleteDatabase db", "DeleteDatabase db-moving"}) {
+		t.Errorf("expected the original and temporary databases to be deleted, got %v", calls)
+	}
+	// Databases can only be seeded from the same group, so the copy in the
+	// new group is seeded from a dump of the original.
+	if created := fake.created["db-moving"]; created.Group != "new" || created.Seed.Value.Type.Value != seedTypeDump || fake.dumps[created.Seed.Value.URL.Value] != fakeDump(rows) {
+		t.Errorf("expected db-moving to be seeded from a dump of db in group new, got %+v", created)
+	}
+	if created := fake.created["db"]; created.Group != "new" || created.Seed.Value.Type.Value != seedTypeDatabase || created.Seed.Value.Name.Value != "db-moving" {
+		t.Errorf("expected db to be seeded from db-moving in group new, got %+v", created)
+	}
+	if !maps.Equal(fake.rows["db"], rows) {
+		t.Errorf("expected the rows to be moved, got %v", fake.rows["db"])
+	}
+	if got, err := os.ReadFile(snapshotPath); err != nil || string(got) != fakeDump(rows) {
+		t.Errorf("expected final snapshot of the original, got %q (%v)", got, err)
+	}
+	if db := fake.databases["db"]; db.Group.Value != "new" || db.BlockWrites.Value {
+		t.Errorf("expected database to be writable in its new group, got %+v", db)
+	}
+	if state.Group.ValueString() != "new" || state.Database.Group.ValueString() != "new" || state.BlockWrites.ValueBool() {
+		t.Errorf("expected moved database in state, got %+v", state)
+	}
+	if !state.Seed.Equal(curr.Seed) || !state.SeededFrom.Equal(curr.SeededFrom) {
+		t.Errorf("expected seed to be kept from the original, got %v and %v", state.Seed, state.SeededFrom)
+	}
+	if value, _ := resp.Private.GetKey(ctx, databaseMoveKey); value != nil {
+		t.Errorf("expected the move to be cleared from the private state, got %s", value)
+	}
+
+	// A copy which does not match the original leaves the original as it
+	// was, in the state, so that the next apply moves it again.
+	fake.databases["db"].Group = tursoclient.NewOptString("old")
+	fake.calls = nil
+	fake.loseRowsOn["db-moving"] = true
+	resp = testMoveDatabase(t, ctx, r, curr)
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Database copy failed" {
+		t.Fatalf("expected the copy to fail verification, got %v", resp.Diagnostics)
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "table posts has 11 rows instead of 12") {
+		t.Errorf("expected the differences to be described, got %q", detail)
+	}
+	if calls := fake.callsTo("DeleteDatabase"); !slices.Equal(calls, []string{"DeleteDatabase db-moving"}) {
+		t.Errorf("expected only the copy to be deleted, got %v", calls)
+	}
+	if db := fake.databases["db"]; db.Group.Value != "old" || db.BlockWrites.Value {
+		t.Errorf("expected database to be left writable in its group, got %+v", db)
+	}
+	if state := stateOf(resp); state.Name.ValueString() != "db" || state.Group.ValueString() != "old" {
+		t.Errorf("expected the original database in state, got %+v", state)
+	}
+	delete(fake.loseRowsOn, "db-moving")
+
+	// A database which cannot be recreated is replaced in the state by the
+	// copy, which holds its rows and is protected from deletion.
+	fake.calls = nil
+	fake.failOn["CreateDatabase db"] = true
+	resp = testMoveDatabase(t, ctx, r, curr)
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[len(resp.Diagnostics.Errors())-1].Summary() != "Database move incomplete" {
+		t.Fatalf("expected the move to be incomplete, got %v", resp.Diagnostics)
+	}
+	state = stateOf(resp)
+	if state.Name.ValueString() != "db-moving" || state.Group.ValueString() != "new" || !state.DeletionProtection.ValueBool() {
+		t.Errorf("expected the protected copy in state, got %+v", state)
+	}
+	if _, ok := fake.databases["db-moving"]; !ok || !maps.Equal(fake.rows["db-moving"], rows) {
+		t.Errorf("expected the copy to be kept, got %v", fake.rows["db-moving"])
+	}
+}
+
+func TestDatabaseResourceModifyPlan_PlansMove(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &DatabaseResource{tursoProviderConfig: fake.start(t)}
+	groups := &GroupResource{tursoProviderConfig: r.tursoProviderConfig}
+	createGroup(t, ctx, groups, "old", "sjc", "sjc")
+	createGroup(t, ctx, groups, "new", "iad", "iad")
+	stateOf := func(name string) resource_database.DatabaseModel {
+		state := testDatabaseModel(name, "old")
+		if diags := r.readDatabaseResource(ctx, name, &state); diags.HasError() {
+			t.Fatalf("error reading database: %v", diags)
+		}
+		return state
+	}
+
+	fake.addDatabase("db", "old", "db-test-org.turso.io")
+	state := stateOf("db")
+	state.Seed = testSeed(types.StringNull(), map[string]string{"name": "source", "type": seedTypeDatabase, "timestamp": "2024-06-01T12:00:00Z"})
+	resp, create := testPlanDatabaseMove(t, ctx, r, state)
+	if resp.Diagnostics.HasError() || create.Diagnostics.HasError() {
+		t.Fatalf("error modifying plan: %v %v", resp.Diagnostics, create.Diagnostics)
+	}
+	if warnings := resp.Diagnostics.Warnings(); len(warnings) != 1 || warnings[0].Summary() != "Database will be moved" {
+		t.Errorf("expected the move to be described, got %v", resp.Diagnostics)
+	}
+	if value, _ := resp.Private.GetKey(ctx, databaseMoveKey); value == nil {
+		t.Errorf("expected the move to be recorded in the private state")
+	}
+	// The seed of the original cannot be checked against group new, and
+	// is kept by the database which replaces it.
+	var got resource_database.DatabaseModel
+	if diags := create.Plan.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("error decoding plan: %v", diags)
+	}
+	if !got.Seed.Timestamp.Equal(types.StringValue("2024-06-01T12:00:00Z")) || !got.Seed.Url.IsNull() || !got.SeededFrom.IsNull() {
+		t.Errorf("expected seed to be planned from the original, got %v and %v", got.Seed, got.SeededFrom)
+	}
+
+	// A destroy plan clears the move.
+	s := testDatabaseSchema(t, ctx, r)
+	destroyReq := fwresource.ModifyPlanRequest{
+		Plan:    tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+		State:   tfsdk.State{Schema: s},
+		Private: resp.Private,
+	}
+	if diags := destroyReq.State.Set(ctx, state); diags.HasError() {
+		t.Fatalf("error encoding state: %v", diags)
+	}
+	destroyResp := fwresource.ModifyPlanResponse{Plan: destroyReq.Plan, Private: resp.Private}
+	r.ModifyPlan(ctx, destroyReq, &destroyResp)
+	if value, _ := destroyResp.Private.GetKey(ctx, databaseMoveKey); value != nil {
+		t.Errorf("expected the move to be cleared by a destroy plan, got %s", value)
+	}
+
+	// Moving deletes the original, so a protected database is not moved.
+	state.DeletionProtection = types.BoolValue(true)
+	resp, _ = testPlanDatabaseMove(t, ctx, r, state)
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Deletion protection enabled" {
+		t.Errorf("expected deletion protection error, got %v", resp.Diagnostics)
+	}
+
+	fake.addDatabase("parent", "old", "parent-test-org.turso.io").IsSchema = tursoclient.NewOptBool(true)
+	resp, _ = testPlanDatabaseMove(t, ctx, r, stateOf("parent"))
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Database cannot be moved" {
+		t.Errorf("expected schema database move to fail, got %v", resp.Diagnostics)
+	}
+	if calls := fake.callsTo("DeleteDatabase"); len(calls) != 0 {
+		t.Errorf("expected no database to be deleted, got %v", calls)
+	}
+}
+
+func TestMoveStagingName(t *testing.T) {
+	if got := moveStagingName("db"); got != "db-moving" {
+		t.Errorf("expected db-moving, got %q", got)
+	}
+	if got := moveStagingName(strings.Repeat("a", maxNameLength)); len(got) != maxNameLength || !strings.HasSuffix(got, "-moving") {
+		t.Errorf("expected name truncated to %d characters, got %q", maxNameLength, got)
+	}
+}
+
+func TestDatabaseResource_ImportState(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &DatabaseResource{tursoProviderConfig: fake.start(t)}
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: r.tursoProviderConfig}, "test", "sjc", "sjc")
+	s := testDatabaseSchema(t, ctx, r)
+
+	data := testDatabaseModel("db", "test")
+	data.SizeLimit = types.StringValue("256mb")
+	data.AllowAttach = types.BoolValue(true)
+	createReq := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	if diags := createReq.Plan.Set(ctx, data); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, createReq, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("error creating database: %v", createResp.Diagnostics)
+	}
+
+	importState := func(id string) fwresource.ImportStateResponse {
+		resp := fwresource.ImportStateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+		resp.Private = newPrivateState(resp.Private)
+		r.ImportState(ctx, fwresource.ImportStateRequest{ID: id}, &resp)
+		return resp
+	}
+	if resp := importState("other-org/db"); !resp.Diagnostics.HasError() {
+		t.Errorf("expected import from another organization to fail")
+	}
+	importResp := importState("test-org/db")
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("error importing database: %v", importResp.Diagnostics)
+	}
+
+	readResp := fwresource.ReadResponse{State: importResp.State, Private: importResp.Private}
+	r.Read(ctx, fwresource.ReadRequest{State: importResp.State, Private: importResp.Private}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("error reading database: %v", readResp.Diagnostics)
+	}
+	var state resource_database.DatabaseModel
+	if diags := readResp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("error decoding state: %v", diags)
+	}
+	if !state.AllowAttach.Equal(types.BoolValue(true)) || !state.BlockWrites.Equal(types.BoolValue(false)) {
+		t.Errorf("expected settings to be read from the database configuration, got allow_attach %v, block_writes %v", state.AllowAttach, state.BlockWrites)
+	}
+	if !state.SizeLimit.Equal(types.StringValue("256000000")) {
+		t.Errorf("expected size_limit to be read from the database configuration, got %v", state.SizeLimit)
+	}
+	if !state.IsSchema.IsNull() || !state.Schema.IsNull() {
+		t.Errorf("expected schema settings to be read, got is_schema %v, schema %v", state.IsSchema, state.Schema)
+	}
+	if !state.DeletionProtection.Equal(r.tursoProviderConfig.deletionProtection()) {
+		t.Errorf("expected the default deletion protection, got %v", state.DeletionProtection)
+	}
+
+	update := func(private bool) fwresource.UpdateResponse {
+		plan := state
+		plan.SizeLimit = types.StringValue("256mb")
+		plan.Seed = testSeed(types.StringUnknown(), map[string]string{"name": "source"})
+		req := fwresource.UpdateRequest{Plan: tfsdk.Plan{Schema: s}, State: readResp.State}
+		if diags := req.Plan.Set(ctx, plan); diags.HasError() {
+			t.Fatalf("error encoding plan: %v", diags)
+		}
+		resp := fwresource.UpdateResponse{State: tfsdk.State{Schema: s, Raw: req.Plan.Raw.Copy()}}
+		if private {
+			req.Private = readResp.Private
+			resp.Private = readResp.Private
+		}
+		r.Update(ctx, req, &resp)
+		return resp
+	}
+
+	// The seed of a database which was not imported cannot be changed.
+	if resp := update(false); !resp.Diagnostics.HasError() {
+		t.Errorf("expected adding a seed to an existing database to fail")
+	}
+
+	// The first update of an imported database records the configured seed.
+	updateResp := update(true)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("error updating database: %v", updateResp.Diagnostics)
+	}
+	if diags := updateResp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("error decoding state: %v", diags)
+	}
+	if state.Seed.Name.ValueString() != "source" || !state.Seed.SeedType.IsNull() {
+		t.Errorf("expected configured seed to be recorded, got %v", state.Seed)
+	}
+	if imported, _ := updateResp.Private.GetKey(ctx, importedKey); len(imported) != 0 {
+		t.Errorf("expected import marker to be removed, got %s", imported)
+	}
+	if calls := fake.callsTo("CreateDatabase"); len(calls) != 1 {
+		t.Errorf("expected the database not to be recreated, got %v", calls)
+	}
+}
+
+func TestDatabaseResourceModifyPlan_PlansSettings(t *testing.T) {
+	ctx := context.Background()
+	r := &DatabaseResource{}
+	s := testDatabaseSchema(t, ctx, r)
+
+	state := testDatabaseModel("db", "test")
+	state.AllowAttach = types.BoolValue(false)
+	state.BlockReads = types.BoolValue(false)
+	state.BlockWrites = types.BoolValue(false)
+	plan := state
+	plan.AllowAttach = types.BoolValue(true)
+	plan.BlockReads = types.BoolUnknown()
+	attrTypes := resource_database.DatabaseValue{}.AttributeTypes(ctx)
+	attributes := map[string]attr.Value{}
+	for name, t := range attrTypes {
+		v, _ := t.ValueFromTerraform(ctx, tftypes.NewValue(t.TerraformType(ctx), tftypes.UnknownValue))
+		attributes[name] = v
+	}
+	plan.Database = resource_database.NewDatabaseValueMust(attrTypes, attributes)
+
+	req := fwresource.ModifyPlanRequest{
+		Plan:  tfsdk.Plan{Schema: s},
+		State: tfsdk.State{Schema: s},
+	}
+	if diags := req.Plan.Set(ctx, plan); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	if diags := req.State.Set(ctx, state); diags.HasError() {
+		t.Fatalf("error encoding state: %v", diags)
+	}
+	req.Config = tfsdk.Config{Schema: s, Raw: req.Plan.Raw}
+	resp := fwresource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("error modifying plan: %v", resp.Diagnostics)
+	}
+
+	var got resource_database.DatabaseModel
+	if diags := resp.Plan.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("error decoding plan: %v", diags)
+	}
+	if !got.Database.AllowAttach.Equal(types.BoolValue(true)) || !got.Database.BlockWrites.Equal(types.BoolValue(false)) {
+		t.Errorf("expected known settings to be planned, got %v", got.Database)
+	}
+	if !got.Database.BlockReads.IsUnknown() {
+		t.Errorf("expected unknown block_reads to stay unknown, got %v", got.Database.BlockReads)
+	}
+}
+
+func TestDatabaseResourceDelete_DeletionProtection(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &DatabaseResource{tursoProviderConfig: fake.start(t)}
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: r.tursoProviderConfig}, "test", "sjc", "sjc")
+	fake.addDatabase("db", "test", "db-test-org.turso.io")
+	s := testDatabaseSchema(t, ctx, r)
+
+	deleteDatabase := func(deletionProtection types.Bool) fwresource.DeleteResponse {
+		data := testDatabaseModel("db", "test")
+		data.DeletionProtection = deletionProtection
+		state := tfsdk.State{Schema: s}
+		if diags := state.Set(ctx, data); diags.HasError() {
+			t.Fatalf("error encoding state: %v", diags)
+		}
+		resp := fwresource.DeleteResponse{State: state}
+		r.Delete(ctx, fwresource.DeleteRequest{State: state}, &resp)
+		return resp
+	}
+
+	resp := deleteDatabase(types.BoolValue(true))
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Deletion protection enabled" {
+		t.Fatalf("expected deletion protection error, got %v", resp.Diagnostics)
+	}
+	if calls := fake.callsTo("DeleteDatabase"); len(calls) != 0 {
+		t.Errorf("expected database not to be deleted, got %v", calls)
+	}
+
+	// State saved before deletion protection existed does not protect.
+	if resp := deleteDatabase(types.BoolNull()); resp.Diagnostics.HasError() {
+		t.Fatalf("error deleting database: %v", resp.Diagnostics)
+	}
+	if calls := fake.callsTo("DeleteDatabase"); !slices.Equal(calls, []string{"DeleteDatabase db"}) {
+		t.Errorf("expected database to be deleted, got %v", calls)
+	}
+}
+
+func TestDatabaseResourceDelete_WritesFinalSnapshot(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &DatabaseResource{tursoProviderConfig: fake.start(t)}
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: r.tursoProviderConfig}, "test", "sjc", "sjc")
+	fake.addDatabase("db", "test", "db-test-org.turso.io")
+	db := newFakeLibsql()
+	db.dump = "CREATE TABLE users (id INTEGER PRIMARY KEY);\n"
+	db.start(t, r.tursoProviderConfig, "db")
+	s := testDatabaseSchema(t, ctx, r)
+
+	deleteDatabase := func(snapshotPath string) fwresource.DeleteResponse {
+		data := testDatabaseModel("db", "test")
+		data.FinalSnapshot = types.StringValue(snapshotPath)
+		state := tfsdk.State{Schema: s}
+		if diags := state.Set(ctx, data); diags.HasError() {
+			t.Fatalf("error encoding state: %v", diags)
+		}
+		resp := fwresource.DeleteResponse{State: state}
+		r.Delete(ctx, fwresource.DeleteRequest{State: state}, &resp)
+		return resp
+	}
+
+	// A failed export keeps the database.
+	db.failDump = true
+	failedPath := filepath.Join(t.TempDir(), "failed.sql")
+	resp := deleteDatabase(failedPath)
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Final snapshot failed" {
+		t.Fatalf("expected final snapshot error, got %v", resp.Diagnostics)
+	}
+	if calls := fake.callsTo("DeleteDatabase"); len(calls) != 0 {
+		t.Errorf("expected database not to be deleted, got %v", calls)
+	}
+	if entries, err := os.ReadDir(filepath.Dir(failedPath)); err != nil || len(entries) != 0 {
+		t.Errorf("expected no snapshot to be written, got %v (%v)", entries, err)
+	}
+
+	db.failDump = false
+	snapshotPath := filepath.Join(t.TempDir(), "snapshots", "db.sql")
+	if resp := deleteDatabase(snapshotPath); resp.Diagnostics.HasError() {
+		t.Fatalf("error deleting database: %v", resp.Diagnostics)
+	}
+	got, err := os.ReadFile(snapshotPath)
+	if err != nil {
+		t.Fatalf("error reading snapshot: %v", err)
+	}
+	if string(got) != db.dump {
+		t.Errorf("expected snapshot %q, got %q", db.dump, got)
+	}
+	tokenCalls := fake.callsTo("CreateDatabaseToken")
+	if len(tokenCalls) == 0 {
+		t.Errorf("expected a token to be minted for the export")
+	}
+	for _, call := range tokenCalls {
+		if !strings.HasPrefix(call, "CreateDatabaseToken db read-only ") {
+			t.Errorf("expected a read-only token, got %v", call)
+		}
+	}
+	if calls := fake.callsTo("DeleteDatabase"); !slices.Equal(calls, []string{"DeleteDatabase db"}) {
+		t.Errorf("expected database to be deleted, got %v", calls)
+	}
+}
+
+func TestDatabaseResourceModifyPlan_PlansDeletionProtection(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name       string
+		config     *tursoProviderConfig
+		configured types.Bool
+		// existing databases have the deletion protection prior.
+		existing bool
+		prior    types.Bool
+		want     types.Bool
+	}{
+		{name: "unconfigured provider", configured: types.BoolNull(), want: types.BoolValue(true)},
+		{name: "provider default", config: &tursoProviderConfig{DeletionProtection: false}, configured: types.BoolNull(), want: types.BoolValue(false)},
+		{name: "configured", config: &tursoProviderConfig{DeletionProtection: false}, configured: types.BoolValue(true), want: types.BoolValue(true)},
+		{name: "existing", configured: types.BoolNull(), existing: true, prior: types.BoolValue(false), want: types.BoolValue(false)},
+		{name: "existing without protection", configured: types.BoolNull(), existing: true, prior: types.BoolNull(), want: types.BoolNull()},
+		{name: "existing configured", configured: types.BoolValue(true), existing: true, prior: types.BoolValue(false), want: types.BoolValue(true)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &DatabaseResource{tursoProviderConfig: tt.config}
+			s := testDatabaseSchema(t, ctx, r)
+
+			config := testDatabaseModel("db", "test")
+			config.DeletionProtection = tt.configured
+			plan := config
+			if tt.configured.IsNull() {
+				plan.DeletionProtection = types.BoolUnknown()
+			}
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s},
+				Plan:   tfsdk.Plan{Schema: s},
+				State:  tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+			}
+			state := tfsdk.State{Schema: s}
+			if diags := state.Set(ctx, config); diags.HasError() {
+				t.Fatalf("error encoding config: %v", diags)
+			}
+			req.Config.Raw = state.Raw
+			if diags := req.Plan.Set(ctx, plan); diags.HasError() {
+				t.Fatalf("error encoding plan: %v", diags)
+			}
+			if tt.existing {
+				prior := config
+				prior.DeletionProtection = tt.prior
+				if diags := req.State.Set(ctx, prior); diags.HasError() {
+					t.Fatalf("error encoding state: %v", diags)
+				}
+			}
+			resp := fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("error modifying plan: %v", resp.Diagnostics)
+			}
+
+			var got types.Bool
+			resp.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &got)
+			if !got.Equal(tt.want) {
+				t.Errorf("expected deletion_protection %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDatabaseResourceUpgradeState_V0(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &DatabaseResource{tursoProviderConfig: fake.start(t)}
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: r.tursoProviderConfig}, "test", "sjc", "sjc")
+	fake.addDatabase("app", "test", "app-test-org.turso.io")
+	s := testDatabaseSchema(t, ctx, r)
+	if s.Version != 1 {
+		t.Fatalf("expected schema version 1, got %d", s.Version)
+	}
+
+	// State saved by the first release of the provider.
+	const v0 = `{
+		"allow_attach": false,
+		"block_reads": false,
+		"block_writes": true,
+		"database": {
+			"allow_attach": false,
+			"archived": false,
+			"block_reads": false,
+			"block_writes": true,
+			"db_id": "db-app",
+			"group": "test",
+			"hostname": "app-test-org.turso.io",
+			"is_schema": false,
+			"name": "app",
+			"primary_region": "sjc",
+			"regions": ["sjc"],
+			"schema": null,
+			"type": "logical",
+			"version": "0.24.0"
+		},
+		"group": "test",
+		"id": "app",
+		"is_schema": false,
+		"name": "app",
+		"schema": null,
+		"seed": {
+			"name": "parent",
+			"timestamp": null,
+			"type": "database",
+			"url": null
+		},
+		"size_limit": "256mb"
+	}`
+	resp := upgradeState(t, ctx, r, s, 0, v0)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var data resource_database.DatabaseModel
+	if diags := resp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("error decoding state: %v", diags)
+	}
+	if data.Name.ValueString() != "app" || !data.BlockWrites.ValueBool() || data.SizeLimit.ValueString() != "256mb" {
+		t.Errorf("expected the saved attributes to be kept, got %+v", data)
+	}
+	if data.Database.Hostname.ValueString() != "app-test-org.turso.io" || !data.Database.HttpUrl.IsNull() {
+		t.Errorf("expected the saved database to be kept with new attributes unset, got %+v", data.Database)
+	}
+	if data.Seed.Name.ValueString() != "parent" || !data.Seed.DumpFile.IsNull() {
+		t.Errorf("expected the saved seed to be kept with new attributes unset, got %+v", data.Seed)
+	}
+	if data.WaitForReady.IsNull() || data.WaitForReady.ValueBool() {
+		t.Errorf("expected wait_for_ready to default to false, got %s", data.WaitForReady)
+	}
+	if !data.DeletionProtection.IsNull() {
+		t.Errorf("expected deletion_protection to stay unset, got %s", data.DeletionProtection)
+	}
+
+	// The upgraded state can be read, which fills in the computed attributes
+	// added since.
+	readResp := fwresource.ReadResponse{State: resp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: resp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("error reading upgraded state: %v", readResp.Diagnostics)
+	}
+	if diags := readResp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("error decoding state: %v", diags)
+	}
+	if data.Database.HttpUrl.ValueString() == "" {
+		t.Errorf("expected the http_url to be read, got %+v", data.Database)
+	}
+}
@@ -2,15 +2,20 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 
 	"github.com/celest-dev/terraform-provider-turso/internal/resource_group"
 	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/go-faster/jx"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -19,7 +24,10 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &GroupResource{}
 var _ resource.ResourceWithImportState = &GroupResource{}
+var _ resource.ResourceWithIdentity = &GroupResource{}
 var _ resource.ResourceWithConfigValidators = &GroupResource{}
+var _ resource.ResourceWithModifyPlan = &GroupResource{}
+var _ resource.ResourceWithUpgradeState = &GroupResource{}
 
 func NewGroupResource() resource.Resource {
 	return &GroupResource{}
@@ -35,6 +43,137 @@ func (r *GroupResource) Metadata(ctx context.Context, req resource.MetadataReque
 
 func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_group.GroupResourceSchema(ctx)
+	// Bump the version and add an upgrader to UpgradeState whenever saved
+	// state is not compatible with the schema anymore.
+	resp.Schema.Version = 1
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceTimeoutsBlock(ctx),
+	}
+
+	// The Turso API has no endpoint for changing the extensions of an existing
+	// group, so a change must be planned as a replacement.
+	extensionsAttr, ok := resp.Schema.Attributes["extensions"].(schema.StringAttribute)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to configure extensions attribute", "Failed to configure extensions attribute")
+		return
+	}
+	extensionsAttr.PlanModifiers = append(extensionsAttr.PlanModifiers,
+		stringplanmodifier.UseStateForUnknown(),
+		stringplanmodifier.RequiresReplaceIf(
+			requiresReplaceIfExtensionsChanged,
+			"Changing the extensions of a group requires the group to be replaced.",
+			"Changing the extensions of a group requires the group to be replaced.",
+		),
+	)
+	resp.Schema.Attributes["extensions"] = extensionsAttr
+
+	// Likewise, the primary location of a group cannot be moved. Replacing the
+	// group destroys its databases, so it must be confirmed explicitly.
+	primaryAttr, ok := resp.Schema.Attributes["primary"].(schema.StringAttribute)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to configure primary attribute", "Failed to configure primary attribute")
+		return
+	}
+	primaryAttr.PlanModifiers = append(primaryAttr.PlanModifiers,
+		stringplanmodifier.RequiresReplaceIf(
+			requiresReplaceIfPrimaryChanged,
+			"Changing the primary location of a group requires the group to be replaced, which must be confirmed with `replace_on_primary_change`.",
+			"Changing the primary location of a group requires the group to be replaced, which must be confirmed with `replace_on_primary_change`.",
+		),
+	)
+	resp.Schema.Attributes["primary"] = primaryAttr
+
+	// The name, primary location and UUID of a group never change once it is
+	// created. Its locations are planned from the configuration in ModifyPlan.
+	groupAttr, ok := resp.Schema.Attributes["group"].(schema.SingleNestedAttribute)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to configure group attribute", "Failed to configure group attribute")
+		return
+	}
+	groupAttr.PlanModifiers = append(groupAttr.PlanModifiers, unknownAttributesModifier{})
+	if err := useStateForUnknown(groupAttr.Attributes, "name", "primary", "uuid"); err != nil {
+		resp.Diagnostics.AddError("Failed to configure group attribute", err.Error())
+		return
+	}
+	resp.Schema.Attributes["group"] = groupAttr
+
+	nameAttr, ok := resp.Schema.Attributes["name"].(schema.StringAttribute)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to configure name attribute", "Failed to configure name attribute")
+		return
+	}
+	nameAttr.Validators = append(nameAttr.Validators, nameValidators()...)
+	resp.Schema.Attributes["name"] = nameAttr
+}
+
+func (r *GroupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema("group")
+}
+
+// UpgradeState upgrades state saved with earlier versions of the schema.
+func (r *GroupResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				upgradeStateJSON(ctx, req, resp, upgradeGroupStateV0)
+			},
+		},
+	}
+}
+
+// upgradeGroupStateV0 upgrades the state of a group saved before the schema
+// was versioned, by providers which only ever added attributes. Those are
+// null, except for the ones Read would default. Deletion protection stays off
+// until it is saved by an apply.
+func upgradeGroupStateV0(attributes map[string]any) {
+	setDefault(attributes, "replace_on_primary_change", false)
+	setDefault(attributes, "wait_for_ready", false)
+}
+
+// requiresReplaceIfExtensionsChanged requires replacement when the configured
+// extensions differ from the ones applied to the group. Removing extensions
+// from the configuration keeps the existing value, since the group cannot be
+// changed in place.
+func requiresReplaceIfExtensionsChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.Equal(req.StateValue) {
+		return
+	}
+	resp.RequiresReplace = true
+	resp.Diagnostics.AddAttributeWarning(
+		req.Path,
+		"Group will be replaced",
+		fmt.Sprintf(
+			"The extensions of an existing group cannot be changed in place. Changing extensions from %s to %s will destroy and recreate the group, including all of its databases.",
+			req.StateValue, req.ConfigValue,
+		),
+	)
+}
+
+// requiresReplaceIfPrimaryChanged requires replacement when the primary location
+// changes and the replacement has been confirmed with replace_on_primary_change.
+// Without confirmation the plan fails instead.
+func requiresReplaceIfPrimaryChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsUnknown() || req.PlanValue.Equal(req.StateValue) {
+		return
+	}
+
+	var confirmed types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("replace_on_primary_change"), &confirmed)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !confirmed.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Primary location cannot be changed",
+			fmt.Sprintf(
+				"The primary location of an existing group cannot be moved from %s to %s. The group must be replaced instead, which destroys all of its databases. Set `replace_on_primary_change = true` to confirm the replacement.",
+				req.StateValue, req.PlanValue,
+			),
+		)
+		return
+	}
+	resp.RequiresReplace = true
 }
 
 type groupConfigValidator struct{}
@@ -59,7 +198,7 @@ func (p *groupConfigValidator) ValidateResource(ctx context.Context, req resourc
 	var primary types.String
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("primary"), &primary)...)
 
-	if resp.Diagnostics.HasError() {
+	if resp.Diagnostics.HasError() || locations.IsUnknown() {
 		return
 	}
 
@@ -68,6 +207,11 @@ func (p *groupConfigValidator) ValidateResource(ctx context.Context, req resourc
 		return
 	}
 
+	if isProvided(primary) && !slices.Contains(decodeStringSet(locations), primary.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("locations"), "Invalid locations", "The primary location must be included in locations.")
+		return
+	}
+
 	if len(locations.Elements()) == 1 {
 		// OK, will use the only location as primary
 		return
@@ -104,14 +248,110 @@ func (r *GroupResource) Configure(ctx context.Context, req resource.ConfigureReq
 	r.tursoProviderConfig = client
 }
 
+// replacingPrimaryKey is the private state key marking a group which is
+// replaced to change its primary location. The replacement has been confirmed
+// to destroy all of the databases of the group, so Delete does not require the
+// group to be empty. Terraform passes the private state planned for a
+// replacement to the delete, and the mark is removed when the group is created
+// again or planned to be destroyed.
+const replacingPrimaryKey = "replacing_primary"
+
+// privateState is the private state of a resource.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// clearReplacingPrimary removes the replacingPrimaryKey mark from the private
+// state, if it is set.
+func clearReplacingPrimary(ctx context.Context, private privateState) diag.Diagnostics {
+	replacing, diags := private.GetKey(ctx, replacingPrimaryKey)
+	if diags.HasError() || len(replacing) == 0 {
+		return diags
+	}
+	return private.SetKey(ctx, replacingPrimaryKey, nil)
+}
+
+// ModifyPlan plans the default deletion protection, marks a replacement which
+// changes the primary location of the group, and plans the locations reported
+// in the group attribute of an existing group from the configured locations,
+// which Update adds and removes before reading the group back.
+func (r *GroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(clearReplacingPrimary(ctx, resp.Private)...)
+		return
+	}
+	resp.Diagnostics.Append(r.tursoProviderConfig.planDeletionProtection(ctx, req.Config, req.State, &resp.Plan)...)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(planReplacingPrimary(ctx, req, resp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var group resource_group.GroupValue
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("group"), &group)...)
+	var locations types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("locations"), &locations)...)
+	var primary types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("primary"), &primary)...)
+	if resp.Diagnostics.HasError() || !isProvided(group) || !isProvided(locations) || !isProvided(primary) {
+		return
+	}
+	for _, location := range locations.Elements() {
+		if location.IsUnknown() {
+			return
+		}
+	}
+
+	planned := encodeStringSet(mergeLists(decodeStringSet(locations), []string{primary.ValueString()}))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("group").AtName("locations"), planned)...)
+}
+
+// planReplacingPrimary marks the group in the private state if the plan
+// replaces it to change its primary location, as required by
+// requiresReplaceIfPrimaryChanged, and removes the mark otherwise.
+func planReplacingPrimary(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var prior, planned types.String
+	diags := req.State.GetAttribute(ctx, path.Root("primary"), &prior)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("primary"), &planned)...)
+	var confirmed types.Bool
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("replace_on_primary_change"), &confirmed)...)
+	if diags.HasError() {
+		return diags
+	}
+	if prior.IsNull() || !isProvided(planned) || planned.Equal(prior) || !confirmed.ValueBool() {
+		diags.Append(clearReplacingPrimary(ctx, resp.Private)...)
+		return diags
+	}
+	diags.Append(resp.Private.SetKey(ctx, replacingPrimaryKey, []byte("true"))...)
+	return diags
+}
+
+// Create creates the group in its primary location and then adds the remaining
+// locations. If a location cannot be added, the new group is deleted again so
+// that the next apply starts from scratch. If the group cannot be deleted
+// either, it is read back and saved to state so Terraform keeps track of it;
+// since Create returns an error, the resource is marked as tainted and replaced
+// on the next apply. The rollback runs even if the create timeout expired.
 func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resource_group.GroupModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(clearReplacingPrimary(ctx, resp.Private)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var rawLocations basetypes.SetValue
 	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("locations"), &rawLocations)...)
 	if resp.Diagnostics.HasError() {
@@ -144,30 +384,54 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 	group := groupData.Group.Value
 	fmt.Printf("created group: %+v\n", group)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, group.Name.Value)...)
 
 	fmt.Printf("adding locations: %s\n", locations)
-	for _, location := range locations {
-		if location == primaryLocation {
-			continue
-		}
-		res, err := r.Client.AddLocationToGroup(ctx, tursoclient.AddLocationToGroupParams{
-			OrganizationName: r.Organization,
-			GroupName:        group.Name.Value,
-			Location:         location,
+	replicaLocations := slices.DeleteFunc(slices.Clone(locations), func(location string) bool {
+		return location == primaryLocation
+	})
+	_, diags = r.addGroupLocations(ctx, group.Name.Value, replicaLocations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		// The create timeout may have expired, so the rollback runs on a
+		// context of its own.
+		ctx, cancel := rollbackContext(ctx)
+		defer cancel()
+		tflog.Debug(ctx, "rolling back group", map[string]interface{}{
+			"group": group.Name.Value,
 		})
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add location to group, got error: %s", err.Error()))
+		err := r.deleteGroup(ctx, group.Name.Value)
+		if err == nil {
 			return
 		}
-		if _, ok := res.(*tursoclient.AddLocationToGroupOK); !ok {
-			resp.Diagnostics.AddError("Client Error", "Unable to add location to group, got unexpected response")
+		// Keep track of the group if it can be read, so that it is replaced
+		// on the next apply.
+		readDiags := r.readGroupResource(ctx, group.Name.Value, &data)
+		if readDiags.HasError() {
+			resp.Diagnostics.AddError(
+				"Unable to roll back group",
+				fmt.Sprintf("The group %q was created but could not be deleted after a failure, got error: %s. It could not be read either (%s), so it is not tracked by Terraform. Delete or import it before applying again.", group.Name.Value, err.Error(), readDiags[0].Detail()),
+			)
 			return
 		}
+		resp.Diagnostics.AddWarning(
+			"Unable to roll back group",
+			fmt.Sprintf("The group %q was created but could not be deleted after a failure, got error: %s. It has been saved to state and will be replaced on the next apply.", group.Name.Value, err.Error()),
+		)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
 	}
 
 	tflog.Trace(ctx, "created group resource")
 	resp.Diagnostics.Append(r.readGroupResource(ctx, group.Name.Value, &data)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WaitForReady.ValueBool() {
+		resp.Diagnostics.Append(r.waitForGroupReady(ctx, group.Name.Value)...)
+	}
 }
 
 func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -178,13 +442,27 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	resp.Diagnostics.Append(r.readGroupResource(ctx, data.Name.ValueString(), &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, data.Name.ValueString())...)
 }
 
+// Update reconciles the group locations. New locations are added before old
+// ones are removed. If any step fails, the locations added by this update are
+// removed again and the state is refreshed from the API, so it reflects the
+// locations the group actually has and the next plan retries the difference.
+// The rollback runs even if the update timeout expired.
 func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data resource_group.GroupModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -198,6 +476,24 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Resources created by earlier versions of the provider have no identity
+	// until they are read.
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, curr.Name.ValueString())...)
+
+	if data.Primary.ValueString() != curr.Primary.ValueString() {
+		// Primary changes are planned as replacements, see requiresReplaceIfPrimaryChanged.
+		resp.Diagnostics.AddAttributeError(path.Root("primary"), "Invalid primary location", "The primary location of an existing group cannot be changed in place.")
+		return
+	}
+
 	currentLocations := decodeStringSet(curr.Locations)
 	requestedLocations := decodeStringSet(data.Locations)
 
@@ -207,44 +503,43 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 			addLocations = append(addLocations, location)
 		}
 	}
-	fmt.Printf("adding locations: %+v\n", addLocations)
-	for _, location := range addLocations {
-		res, err := r.Client.AddLocationToGroup(ctx, tursoclient.AddLocationToGroupParams{
-			OrganizationName: r.Organization,
-			GroupName:        data.Name.ValueString(),
-			Location:         location,
-		})
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add location to group, got error: %s", err.Error()))
-			return
-		}
-		if _, ok := res.(*tursoclient.AddLocationToGroupOK); !ok {
-			resp.Diagnostics.AddError("Client Error", "Unable to add location to group, got unexpected response")
-			return
-		}
-	}
-
 	removeLocations := make([]string, 0, len(currentLocations))
 	for _, location := range currentLocations {
+		if location == curr.Primary.ValueString() {
+			continue
+		}
 		if !slices.Contains(requestedLocations, location) {
 			removeLocations = append(removeLocations, location)
 		}
 	}
-	fmt.Printf("removing locations: %+v\n", removeLocations)
-	for _, location := range removeLocations {
-		res, err := r.Client.RemoveLocationFromGroup(ctx, tursoclient.RemoveLocationFromGroupParams{
-			OrganizationName: r.Organization,
-			GroupName:        data.Name.ValueString(),
-			Location:         location,
-		})
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove location from group, got error: %s", err.Error()))
-			return
-		}
-		if _, ok := res.(*tursoclient.RemoveLocationFromGroupOK); !ok {
-			resp.Diagnostics.AddError("Client Error", "Unable to remove location from group, got unexpected response")
+
+	fmt.Printf("adding locations: %+v\n", addLocations)
+	added, diags := r.addGroupLocations(ctx, data.Name.ValueString(), addLocations)
+	resp.Diagnostics.Append(diags...)
+	if !resp.Diagnostics.HasError() {
+		fmt.Printf("removing locations: %+v\n", removeLocations)
+		resp.Diagnostics.Append(r.removeGroupLocations(ctx, data.Name.ValueString(), removeLocations)...)
+	}
+	if resp.Diagnostics.HasError() {
+		// The update timeout may have expired, so the rollback runs on a
+		// context of its own.
+		ctx, cancel := rollbackContext(ctx)
+		defer cancel()
+		resp.Diagnostics.Append(r.rollbackGroupLocations(ctx, data.Name.ValueString(), added)...)
+		// The prior state is kept if the group cannot be read back, since
+		// the planned state has unknown values.
+		refreshed := curr
+		readDiags := r.readGroupResource(ctx, curr.Name.ValueString(), &refreshed)
+		if readDiags.HasError() {
+			resp.Diagnostics.AddError(
+				"Unable to read group",
+				fmt.Sprintf("The locations of group %q may differ from the state after the failed update, and the group could not be read back, got error: %s. Run terraform refresh before applying again.", curr.Name.ValueString(), readDiags[0].Detail()),
+			)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &curr)...)
 			return
 		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &refreshed)...)
+		return
 	}
 
 	resp.Diagnostics.Append(r.readGroupResource(ctx, data.Name.ValueString(), &data)...)
@@ -252,6 +547,13 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(added) > 0 && data.WaitForReady.ValueBool() {
+		resp.Diagnostics.Append(r.waitForGroupReady(ctx, data.Name.ValueString())...)
+	}
 }
 
 func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -264,20 +566,136 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(checkDeletionProtection(data.DeletionProtection, "group", data.Name.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Replacing the group to change its primary location has been confirmed
+	// to destroy all of its databases.
+	replacing, diags := req.Private.GetKey(ctx, replacingPrimaryKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(replacing) == 0 {
+		resp.Diagnostics.Append(r.checkGroupEmpty(ctx, data.Name.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	fmt.Printf("deleting group: %+v\n", data)
-	_, err := r.Client.DeleteGroup(ctx, tursoclient.DeleteGroupParams{
-		OrganizationName: r.Organization,
-		GroupName:        data.Name.ValueString(),
-	})
-	if err != nil {
+	if err := r.deleteGroup(ctx, data.Name.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete group, got error: %s", err.Error()))
 		return
 	}
 }
 
+func (r *GroupResource) deleteGroup(ctx context.Context, name string) error {
+	_, err := r.Client.DeleteGroup(ctx, tursoclient.DeleteGroupParams{
+		OrganizationName: r.Organization,
+		GroupName:        name,
+	})
+	return err
+}
+
+// ImportState imports a group by its name, optionally qualified by the
+// organization as organization/name, or by its identity.
 func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	fmt.Printf("importing group: %+v\n", req)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	name, diags := r.tursoProviderConfig.importResourceName(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), r.tursoProviderConfig.deletionProtection())...)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, name)...)
+}
+
+// addGroupLocations adds the locations to the group, running up to the
+// provider parallelism requests at once. Every location is attempted, with an
+// error diagnostic for each one which failed. It returns the locations which
+// were added successfully.
+func (r *GroupResource) addGroupLocations(ctx context.Context, groupName string, locations []string) ([]string, diag.Diagnostics) {
+	errs := forEachParallel(ctx, r.Parallelism, locations, func(ctx context.Context, location string) error {
+		res, err := r.Client.AddLocationToGroup(ctx, tursoclient.AddLocationToGroupParams{
+			OrganizationName: r.Organization,
+			GroupName:        groupName,
+			Location:         location,
+		})
+		if err != nil {
+			return err
+		}
+		if _, ok := res.(*tursoclient.AddLocationToGroupOK); !ok {
+			return errors.New("unexpected response")
+		}
+		return nil
+	})
+
+	var diags diag.Diagnostics
+	added := make([]string, 0, len(locations))
+	for i, location := range locations {
+		if errs[i] != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to add location %q to group, got error: %s", location, errs[i].Error()))
+			continue
+		}
+		added = append(added, location)
+	}
+	return added, diags
+}
+
+// removeGroupLocations removes the locations from the group, running up to the
+// provider parallelism requests at once. Every location is attempted, with an
+// error diagnostic for each one which failed.
+func (r *GroupResource) removeGroupLocations(ctx context.Context, groupName string, locations []string) diag.Diagnostics {
+	errs := forEachParallel(ctx, r.Parallelism, locations, func(ctx context.Context, location string) error {
+		res, err := r.Client.RemoveLocationFromGroup(ctx, tursoclient.RemoveLocationFromGroupParams{
+			OrganizationName: r.Organization,
+			GroupName:        groupName,
+			Location:         location,
+		})
+		if err != nil {
+			return err
+		}
+		if _, ok := res.(*tursoclient.RemoveLocationFromGroupOK); !ok {
+			return errors.New("unexpected response")
+		}
+		return nil
+	})
+
+	var diags diag.Diagnostics
+	for i, location := range locations {
+		if errs[i] != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to remove location %q from group, got error: %s", location, errs[i].Error()))
+		}
+	}
+	return diags
+}
+
+// rollbackGroupLocations removes locations added by a failed operation. Errors
+// are reported as warnings since the operation has already failed.
+func (r *GroupResource) rollbackGroupLocations(ctx context.Context, groupName string, added []string) diag.Diagnostics {
+	if len(added) == 0 {
+		return nil
+	}
+	tflog.Debug(ctx, "rolling back group locations", map[string]interface{}{
+		"group":     groupName,
+		"locations": added,
+	})
+	var diags diag.Diagnostics
+	for _, d := range r.removeGroupLocations(ctx, groupName, added) {
+		diags.AddWarning("Unable to roll back group location", d.Detail()+". The location may need to be removed manually.")
+	}
+	return diags
 }
 
 func (r *tursoProviderConfig) readGroup(ctx context.Context, name string) (tursoclient.BaseGroup, diag.Diagnostics) {
@@ -306,11 +724,25 @@ func (r *GroupResource) readGroupResource(ctx context.Context, name string, data
 	if diags.HasError() {
 		return diags
 	}
+	return setGroupResource(ctx, group, data)
+}
+
+// setGroupResource records the group reported by the API in data.
+func setGroupResource(ctx context.Context, group tursoclient.BaseGroup, data *resource_group.GroupModel) diag.Diagnostics {
+	var diags diag.Diagnostics
 
 	data.Id = types.StringValue(group.Name.Value)
 	data.Name = types.StringValue(group.Name.Value)
 	data.Primary = types.StringValue(group.Primary.Value)
-	if data.Extensions.IsUnknown() {
+	if !isProvided(data.ReplaceOnPrimaryChange) {
+		data.ReplaceOnPrimaryChange = types.BoolValue(false)
+	}
+	if !isProvided(data.WaitForReady) {
+		data.WaitForReady = types.BoolValue(false)
+	}
+	if extensions, ok := decodeGroupExtensions(group.Extensions); ok {
+		data.Extensions = extensions
+	} else if data.Extensions.IsUnknown() {
 		data.Extensions = types.StringNull()
 	}
 
@@ -326,3 +758,36 @@ func (r *GroupResource) readGroupResource(ctx context.Context, name string, data
 	})
 	return diags
 }
+
+// decodeGroupExtensions decodes the extensions reported for a group. Older API
+// versions omit the field, in which case ok is false and the caller should keep
+// the value it already has.
+//
+// The OpenAPI spec describes the field without a type, so the generated client
+// keeps the raw JSON. Only a string such as "all" or null is decoded, so that a
+// value of another type cannot fail reading the whole group.
+func decodeGroupExtensions(raw jx.Raw) (extensions types.String, ok bool) {
+	if len(raw) == 0 {
+		return types.StringNull(), false
+	}
+	d := jx.DecodeBytes(raw)
+	switch d.Next() {
+	case jx.Null:
+		return types.StringNull(), true
+	case jx.String:
+		s, err := d.Str()
+		if err != nil || s == "" {
+			return types.StringNull(), err == nil
+		}
+		return types.StringValue(s), true
+	default:
+		return types.StringNull(), false
+	}
+}
+
+// groupExtensions returns the extensions reported for a group, or null when
+// they are not reported, see decodeGroupExtensions.
+func groupExtensions(raw jx.Raw) types.String {
+	extensions, _ := decodeGroupExtensions(raw)
+	return extensions
+}
@@ -1,12 +1,28 @@
 package provider
 
 import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/celest-dev/terraform-provider-turso/internal/resource_group"
+	"github.com/go-faster/jx"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccResourceGroup(t *testing.T) {
@@ -63,3 +79,709 @@ func TestAccResourceGroup(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceGroupIdentity(t *testing.T) {
+	name := randomName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_group" "test" {
+					name = "` + name + `"
+					locations = ["sjc"]
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("turso_group.test", map[string]knownvalue.Check{
+						"organization": knownvalue.StringExact("celest-dev"),
+						"name":         knownvalue.StringExact(name),
+					}),
+				},
+			},
+
+			// Import with an import block by identity
+			{
+				ResourceName:    "turso_group.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupPlansLocations(t *testing.T) {
+	name := randomName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_group" "test" {
+					name = "` + name + `"
+					primary = "sjc"
+					locations = ["sjc"]
+				}`),
+			},
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_group" "test" {
+					name = "` + name + `"
+					primary = "sjc"
+					locations = ["sjc", "dfw"]
+				}`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("turso_group.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("turso_group.test", tfjsonpath.New("group").AtMapKey("uuid"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("turso_group.test", tfjsonpath.New("group").AtMapKey("locations"), knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("sjc"),
+							knownvalue.StringExact("dfw"),
+						})),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupExtensions(t *testing.T) {
+	name := randomName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create without extensions
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_group" "test" {
+					name = "` + name + `"
+					primary = "sjc"
+					locations = ["sjc"]
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("turso_group.test", tfjsonpath.New("extensions"), knownvalue.Null()),
+				},
+			},
+
+			// Enabling extensions replaces the group
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_group" "test" {
+					name = "` + name + `"
+					primary = "sjc"
+					locations = ["sjc"]
+					extensions = "all"
+				}`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("turso_group.test", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("turso_group.test", tfjsonpath.New("extensions"), knownvalue.StringExact("all")),
+				},
+			},
+
+			// Removing extensions from the configuration keeps the group
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_group" "test" {
+					name = "` + name + `"
+					primary = "sjc"
+					locations = ["sjc"]
+				}`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("turso_group.test", plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupPrimaryChange(t *testing.T) {
+	name := randomName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read test
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_group" "test" {
+					name = "` + name + `"
+					primary = "sjc"
+					locations = ["sjc", "dfw"]
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("turso_group.test", tfjsonpath.New("primary"), knownvalue.StringExact("sjc")),
+					statecheck.ExpectKnownValue("turso_group.test", tfjsonpath.New("replace_on_primary_change"), knownvalue.Bool(false)),
+				},
+			},
+
+			// Changing the primary without confirmation fails the plan
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_group" "test" {
+					name = "` + name + `"
+					primary = "dfw"
+					locations = ["sjc", "dfw"]
+				}`),
+				ExpectError: regexp.MustCompile(`Primary location cannot be changed`),
+			},
+
+			// Changing the primary with confirmation replaces the group
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_group" "test" {
+					name = "` + name + `"
+					primary = "dfw"
+					locations = ["sjc", "dfw"]
+					replace_on_primary_change = true
+				}`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("turso_group.test", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("turso_group.test", tfjsonpath.New("primary"), knownvalue.StringExact("dfw")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupPrimaryNotInLocations(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateConfig(`
+				resource "turso_group" "test" {
+					name = "` + randomName() + `"
+					primary = "sjc"
+					locations = ["dfw", "sea"]
+				}`),
+				ExpectError: regexp.MustCompile(`The primary location must be included in locations`),
+			},
+		},
+	})
+}
+
+func testGroupModel(t *testing.T, ctx context.Context, name, primary string, locations ...string) resource_group.GroupModel {
+	t.Helper()
+
+	locationsVal, diags := types.SetValueFrom(ctx, types.StringType, locations)
+	if diags.HasError() {
+		t.Fatalf("error encoding locations: %v", diags)
+	}
+	return resource_group.GroupModel{
+		Extensions:             types.StringNull(),
+		Group:                  resource_group.NewGroupValueUnknown(),
+		Id:                     types.StringUnknown(),
+		Primary:                types.StringValue(primary),
+		Locations:              locationsVal,
+		Name:                   types.StringValue(name),
+		DeletionProtection:     types.BoolValue(false),
+		ReplaceOnPrimaryChange: types.BoolValue(false),
+		WaitForReady:           types.BoolValue(false),
+		Timeouts:               testTimeoutsNull(),
+	}
+}
+
+// testTimeoutsNull returns an unset timeouts block.
+func testTimeoutsNull() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+}
+
+// testTimeouts returns a timeouts block with only the named timeout set.
+func testTimeouts(name, value string) timeouts.Value {
+	attrTypes := testTimeoutsNull().AttributeTypes(context.Background())
+	attrs := make(map[string]attr.Value, len(attrTypes))
+	for n := range attrTypes {
+		attrs[n] = types.StringNull()
+	}
+	attrs[name] = types.StringValue(value)
+	return timeouts.Value{Object: types.ObjectValueMust(attrTypes, attrs)}
+}
+
+func sortedStrings(s []string) []string {
+	s = slices.Clone(s)
+	slices.Sort(s)
+	return s
+}
+
+func testGroupSchema(t *testing.T, ctx context.Context, r *GroupResource) schema.Schema {
+	t.Helper()
+
+	var resp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("error building schema: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+func testGroupState(t *testing.T, ctx context.Context, state tfsdk.State) resource_group.GroupModel {
+	t.Helper()
+
+	var data resource_group.GroupModel
+	if diags := state.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("error decoding state: %v", diags)
+	}
+	return data
+}
+
+// createGroup creates a group using the resource, failing the test on error.
+func createGroup(t *testing.T, ctx context.Context, r *GroupResource, name, primary string, locations ...string) tfsdk.State {
+	t.Helper()
+
+	s := testGroupSchema(t, ctx, r)
+	req := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	if diags := req.Plan.Set(ctx, testGroupModel(t, ctx, name, primary, locations...)); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("error creating group: %v", resp.Diagnostics)
+	}
+	return resp.State
+}
+
+func TestGroupResourceCreate_RollsBackOnLocationFailure(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	fake.failOn["AddLocationToGroup test sea"] = true
+	r := &GroupResource{tursoProviderConfig: fake.start(t)}
+	s := testGroupSchema(t, ctx, r)
+
+	req := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	if diags := req.Plan.Set(ctx, testGroupModel(t, ctx, "test", "sjc", "sjc", "dfw", "sea")); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, req, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error, got none")
+	}
+	if len(fake.groups) != 0 {
+		t.Errorf("expected group to be deleted, got %v", fake.groups)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("expected no state to be saved, got %v", resp.State.Raw)
+	}
+}
+
+func TestGroupResourceCreate_SavesStateWhenRollbackFails(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	fake.failOn["AddLocationToGroup test sea"] = true
+	fake.failOn["DeleteGroup test"] = true
+	r := &GroupResource{tursoProviderConfig: fake.start(t)}
+	s := testGroupSchema(t, ctx, r)
+
+	req := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	if diags := req.Plan.Set(ctx, testGroupModel(t, ctx, "test", "sjc", "sjc", "dfw", "sea")); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, req, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error, got none")
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("expected a rollback warning, got %v", resp.Diagnostics)
+	}
+	data := testGroupState(t, ctx, resp.State)
+	if data.Name.ValueString() != "test" {
+		t.Errorf("expected group to be saved to state, got %v", data)
+	}
+	if got := decodeStringSet(data.Locations); !slices.Equal(sortedStrings(got), []string{"dfw", "sjc"}) {
+		t.Errorf("expected state to contain the locations which were added, got %v", got)
+	}
+}
+
+func TestGroupResourceCreate_RollsBackAfterTimeout(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	fake.hangOn["AddLocationToGroup test sea"] = true
+	r := &GroupResource{tursoProviderConfig: fake.start(t)}
+	s := testGroupSchema(t, ctx, r)
+
+	data := testGroupModel(t, ctx, "test", "sjc", "sjc", "sea")
+	data.Timeouts = testTimeouts("create", "100ms")
+	req := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	if diags := req.Plan.Set(ctx, data); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, req, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error, got none")
+	}
+	if len(fake.groups) != 0 {
+		t.Errorf("expected group to be deleted after the timeout, got %v", fake.groups)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("expected no state to be saved, got %v", resp.State.Raw)
+	}
+}
+
+func TestGroupResourceCreate_ReportsUnreadableGroupWhenRollbackFails(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	fake.failOn["AddLocationToGroup test sea"] = true
+	fake.failOn["DeleteGroup test"] = true
+	fake.failOn["GetGroup test"] = true
+	r := &GroupResource{tursoProviderConfig: fake.start(t)}
+	s := testGroupSchema(t, ctx, r)
+
+	req := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	if diags := req.Plan.Set(ctx, testGroupModel(t, ctx, "test", "sjc", "sjc", "sea")); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, req, &resp)
+
+	errs := resp.Diagnostics.Errors()
+	if len(errs) == 0 || errs[len(errs)-1].Summary() != "Unable to roll back group" || !strings.Contains(errs[len(errs)-1].Detail(), `"test"`) {
+		t.Fatalf("expected an error naming the group, got %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("expected no state to be saved from an unread group, got %v", resp.State.Raw)
+	}
+}
+
+func TestGroupResourceUpdate_RollsBackAddedLocations(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	config := fake.start(t)
+	r := &GroupResource{tursoProviderConfig: config}
+	s := testGroupSchema(t, ctx, r)
+
+	state := createGroup(t, ctx, r, "test", "sjc", "sjc", "dfw")
+
+	// Replace dfw with sea and ams, failing to remove dfw
+	fake.failOn["RemoveLocationFromGroup test dfw"] = true
+	updateReq := fwresource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: s},
+		State: state,
+	}
+	if diags := updateReq.Plan.Set(ctx, testGroupModel(t, ctx, "test", "sjc", "sjc", "sea", "ams")); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	updateResp := fwresource.UpdateResponse{State: tfsdk.State{Schema: s, Raw: updateReq.Plan.Raw.Copy()}}
+	r.Update(ctx, updateReq, &updateResp)
+
+	if !updateResp.Diagnostics.HasError() {
+		t.Fatalf("expected error, got none")
+	}
+	if got := fake.callsTo("RemoveLocationFromGroup"); !slices.Equal(sortedStrings(got), []string{
+		"RemoveLocationFromGroup test ams",
+		"RemoveLocationFromGroup test dfw",
+		"RemoveLocationFromGroup test sea",
+	}) {
+		t.Errorf("expected added locations to be rolled back, got %v", got)
+	}
+	data := testGroupState(t, ctx, updateResp.State)
+	if got := decodeStringSet(data.Locations); !slices.Equal(sortedStrings(got), []string{"dfw", "sjc"}) {
+		t.Errorf("expected state to match the group locations, got %v", got)
+	}
+}
+
+func TestGroupResourceModifyPlan_PlansLocations(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &GroupResource{tursoProviderConfig: fake.start(t)}
+	s := testGroupSchema(t, ctx, r)
+	state := createGroup(t, ctx, r, "test", "sjc", "sjc")
+
+	plan := testGroupState(t, ctx, state)
+	plan.Locations = encodeStringSet([]string{"sjc", "dfw"})
+	req := fwresource.ModifyPlanRequest{Plan: tfsdk.Plan{Schema: s}, State: state}
+	if diags := req.Plan.Set(ctx, plan); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	req.Config = tfsdk.Config{Schema: s, Raw: req.Plan.Raw}
+	resp := fwresource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("error modifying plan: %v", resp.Diagnostics)
+	}
+
+	var got resource_group.GroupModel
+	if diags := resp.Plan.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("error decoding plan: %v", diags)
+	}
+	if locations := decodeStringSet(got.Group.Locations); !slices.Equal(sortedStrings(locations), []string{"dfw", "sjc"}) {
+		t.Errorf("expected planned locations, got %v", locations)
+	}
+	if got.Group.Uuid.ValueString() != "uuid-test" {
+		t.Errorf("expected uuid to be kept, got %v", got.Group.Uuid)
+	}
+}
+
+func TestGroupResourceModifyPlan_MarksPrimaryReplacement(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &GroupResource{tursoProviderConfig: fake.start(t)}
+	s := testGroupSchema(t, ctx, r)
+	state := createGroup(t, ctx, r, "test", "sjc", "sjc", "dfw")
+
+	// The private state is carried from one plan to the next, as Terraform
+	// does for the two plans of a replacement.
+	private := newPrivateState(fwresource.ModifyPlanResponse{}.Private)
+	modifyPlan := func(update func(*resource_group.GroupModel)) fwresource.ModifyPlanResponse {
+		req := fwresource.ModifyPlanRequest{Plan: tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}, State: state, Private: private}
+		if update != nil {
+			plan := testGroupState(t, ctx, state)
+			update(&plan)
+			if diags := req.Plan.Set(ctx, plan); diags.HasError() {
+				t.Fatalf("error encoding plan: %v", diags)
+			}
+		}
+		req.Config = tfsdk.Config{Schema: s, Raw: req.Plan.Raw}
+		resp := fwresource.ModifyPlanResponse{Plan: req.Plan, Private: private}
+		r.ModifyPlan(ctx, req, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("error modifying plan: %v", resp.Diagnostics)
+		}
+		return resp
+	}
+	replacing := func(resp fwresource.ModifyPlanResponse) bool {
+		value, diags := resp.Private.GetKey(ctx, replacingPrimaryKey)
+		if diags.HasError() {
+			t.Fatalf("error reading private state: %v", diags)
+		}
+		return len(value) != 0
+	}
+
+	resp := modifyPlan(func(data *resource_group.GroupModel) {
+		data.Primary = types.StringValue("dfw")
+	})
+	if replacing(resp) {
+		t.Errorf("expected a primary change without confirmation not to be marked")
+	}
+
+	resp = modifyPlan(func(data *resource_group.GroupModel) {
+		data.Primary = types.StringValue("dfw")
+		data.ReplaceOnPrimaryChange = types.BoolValue(true)
+	})
+	if !replacing(resp) {
+		t.Errorf("expected a confirmed primary change to be marked")
+	}
+
+	// A plain destroy must still refuse a group with databases.
+	resp = modifyPlan(nil)
+	if replacing(resp) {
+		t.Errorf("expected a destroy plan not to be marked")
+	}
+}
+
+func TestGroupResourceDelete_RefusesGroupWithDatabases(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &GroupResource{tursoProviderConfig: fake.start(t)}
+	s := testGroupSchema(t, ctx, r)
+	state := createGroup(t, ctx, r, "test", "sjc", "sjc")
+	fake.addDatabase("other", "test", "other-test-org.turso.io")
+
+	deleteGroup := func(replacing bool, update func(*resource_group.GroupModel)) fwresource.DeleteResponse {
+		data := testGroupState(t, ctx, state)
+		update(&data)
+		state := tfsdk.State{Schema: s}
+		if diags := state.Set(ctx, data); diags.HasError() {
+			t.Fatalf("error encoding state: %v", diags)
+		}
+		req := fwresource.DeleteRequest{State: state}
+		if replacing {
+			req.Private = newPrivateState(req.Private)
+			if diags := req.Private.SetKey(ctx, replacingPrimaryKey, []byte("true")); diags.HasError() {
+				t.Fatalf("error setting private state: %v", diags)
+			}
+		}
+		resp := fwresource.DeleteResponse{State: state}
+		r.Delete(ctx, req, &resp)
+		return resp
+	}
+
+	resp := deleteGroup(false, func(data *resource_group.GroupModel) {
+		data.DeletionProtection = types.BoolValue(true)
+	})
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Deletion protection enabled" {
+		t.Errorf("expected deletion protection error, got %v", resp.Diagnostics)
+	}
+
+	for _, replaceOnPrimaryChange := range []bool{false, true} {
+		resp = deleteGroup(false, func(data *resource_group.GroupModel) {
+			data.ReplaceOnPrimaryChange = types.BoolValue(replaceOnPrimaryChange)
+		})
+		if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Detail() != `Group "test" still has the databases other, which are not being destroyed with it. Delete them before deleting the group.` {
+			t.Errorf("expected group with databases error, got %v", resp.Diagnostics)
+		}
+		if calls := fake.callsTo("DeleteGroup"); len(calls) != 0 {
+			t.Errorf("expected group not to be deleted, got %v", calls)
+		}
+	}
+
+	// Replacing the group to move its primary location destroys its databases.
+	resp = deleteGroup(true, func(data *resource_group.GroupModel) {
+		data.ReplaceOnPrimaryChange = types.BoolValue(true)
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("error deleting group: %v", resp.Diagnostics)
+	}
+	if calls := fake.callsTo("DeleteGroup"); !slices.Equal(calls, []string{"DeleteGroup test"}) {
+		t.Errorf("expected group to be deleted, got %v", calls)
+	}
+}
+
+func TestGroupResourceCreate_SetsIdentity(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &GroupResource{tursoProviderConfig: fake.start(t)}
+	s := testGroupSchema(t, ctx, r)
+
+	var identityResp fwresource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identityResp)
+	is := identityResp.IdentitySchema
+
+	req := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	if diags := req.Plan.Set(ctx, testGroupModel(t, ctx, "test", "sjc", "sjc")); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	resp := fwresource.CreateResponse{
+		State:    tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+		Identity: &tfsdk.ResourceIdentity{Schema: is, Raw: tftypes.NewValue(is.Type().TerraformType(ctx), nil)},
+	}
+	r.Create(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("error creating group: %v", resp.Diagnostics)
+	}
+
+	var identity resourceIdentityModel
+	if diags := resp.Identity.Get(ctx, &identity); diags.HasError() {
+		t.Fatalf("error decoding identity: %v", diags)
+	}
+	want := resourceIdentityModel{Organization: types.StringValue("test-org"), Name: types.StringValue("test")}
+	if identity != want {
+		t.Errorf("expected identity %+v, got %+v", want, identity)
+	}
+}
+
+func TestGroupResourceUpgradeState_V0(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &GroupResource{tursoProviderConfig: fake.start(t)}
+	s := testGroupSchema(t, ctx, r)
+	if s.Version != 1 {
+		t.Fatalf("expected schema version 1, got %d", s.Version)
+	}
+
+	// State saved by the first release of the provider.
+	const v0 = `{
+		"extensions": null,
+		"group": {
+			"archived": false,
+			"locations": ["sjc", "dfw"],
+			"name": "test",
+			"primary": "sjc",
+			"uuid": "uuid-test",
+			"version": "v0.24.0"
+		},
+		"id": "test",
+		"locations": ["sjc", "dfw"],
+		"name": "test",
+		"primary": "sjc"
+	}`
+	resp := upgradeState(t, ctx, r, s, 0, v0)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	data := testGroupState(t, ctx, resp.State)
+	if data.Name.ValueString() != "test" || data.Primary.ValueString() != "sjc" || data.Group.Uuid.ValueString() != "uuid-test" {
+		t.Errorf("expected the saved attributes to be kept, got %+v", data)
+	}
+	if got := sortedStrings(decodeStringSet(data.Locations)); !slices.Equal(got, []string{"dfw", "sjc"}) {
+		t.Errorf("expected locations [dfw sjc], got %v", got)
+	}
+	if data.ReplaceOnPrimaryChange.IsNull() || data.ReplaceOnPrimaryChange.ValueBool() {
+		t.Errorf("expected replace_on_primary_change to default to false, got %s", data.ReplaceOnPrimaryChange)
+	}
+	if data.WaitForReady.IsNull() || data.WaitForReady.ValueBool() {
+		t.Errorf("expected wait_for_ready to default to false, got %s", data.WaitForReady)
+	}
+	if !data.DeletionProtection.IsNull() {
+		t.Errorf("expected deletion_protection to stay unset, got %s", data.DeletionProtection)
+	}
+	if !data.Timeouts.IsNull() {
+		t.Errorf("expected no timeouts, got %s", data.Timeouts)
+	}
+
+	// State saved before versioning with the attributes added since keeps them.
+	const v0WithSettings = `{
+		"deletion_protection": true,
+		"extensions": "all",
+		"group": null,
+		"id": "test",
+		"locations": ["sjc"],
+		"name": "test",
+		"primary": "sjc",
+		"replace_on_primary_change": true,
+		"timeouts": {"create": "1h", "read": null, "update": null, "delete": null},
+		"wait_for_ready": true
+	}`
+	resp = upgradeState(t, ctx, r, s, 0, v0WithSettings)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	data = testGroupState(t, ctx, resp.State)
+	if !data.DeletionProtection.ValueBool() || !data.ReplaceOnPrimaryChange.ValueBool() || !data.WaitForReady.ValueBool() || data.Extensions.ValueString() != "all" {
+		t.Errorf("expected the saved settings to be kept, got %+v", data)
+	}
+	if timeout, _ := data.Timeouts.Create(ctx, defaultCreateTimeout); timeout != time.Hour {
+		t.Errorf("expected a create timeout of 1h, got %s", timeout)
+	}
+}
+
+func TestDecodeGroupExtensions(t *testing.T) {
+	tests := []struct {
+		name   string
+		raw    string
+		want   types.String
+		wantOK bool
+	}{
+		{name: "omitted", raw: "", want: types.StringNull()},
+		{name: "null", raw: `null`, want: types.StringNull(), wantOK: true},
+		{name: "empty", raw: `""`, want: types.StringNull(), wantOK: true},
+		{name: "all", raw: `"all"`, want: types.StringValue("all"), wantOK: true},
+		{name: "other type", raw: `["vector"]`, want: types.StringNull()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := decodeGroupExtensions(jx.Raw(tt.raw))
+			if !got.Equal(tt.want) || ok != tt.wantOK {
+				t.Errorf("expected %v, %v, got %v, %v", tt.want, tt.wantOK, got, ok)
+			}
+		})
+	}
+}
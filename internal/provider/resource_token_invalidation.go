@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/resource_token_invalidation"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TokenInvalidationResource{}
+var _ resource.ResourceWithConfigValidators = &TokenInvalidationResource{}
+
+func NewTokenInvalidationResource() resource.Resource {
+	return &TokenInvalidationResource{}
+}
+
+// TokenInvalidationResource invalidates the tokens of a database or group
+// when it is created, and again whenever its triggers change. The API keeps no
+// record of invalidations, so there is nothing to import and the resource has
+// no identity.
+type TokenInvalidationResource struct {
+	*tursoProviderConfig
+}
+
+func (r *TokenInvalidationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_token_invalidation"
+}
+
+func (r *TokenInvalidationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_token_invalidation.TokenInvalidationResourceSchema(ctx)
+	resp.Schema.Description = "Invalidates all tokens of a database or group when created, and again whenever triggers change. Tokens created afterwards are valid, so resources which create tokens can depend on invalidated_at to be replaced with fresh tokens. Destroying the resource does not invalidate any tokens. The resource cannot be imported, since it records an invalidation made by Terraform rather than an object which exists in Turso."
+	resp.Schema.MarkdownDescription = "Invalidates all tokens of a database or group when created, and again whenever `triggers` change. Tokens created afterwards are valid, so resources which create tokens can depend on `invalidated_at` to be replaced with fresh tokens. Destroying the resource does not invalidate any tokens. The resource cannot be imported, since it records an invalidation made by Terraform rather than an object which exists in Turso."
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceTimeoutsBlock(ctx),
+	}
+
+	for _, name := range []string{"database", "group"} {
+		attr, ok := resp.Schema.Attributes[name].(schema.StringAttribute)
+		if !ok {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to configure %s attribute", name), fmt.Sprintf("Failed to configure %s attribute", name))
+			return
+		}
+		attr.Validators = append(attr.Validators, nameValidators()...)
+		attr.PlanModifiers = append(attr.PlanModifiers, stringplanmodifier.RequiresReplace())
+		resp.Schema.Attributes[name] = attr
+	}
+	triggersAttr, ok := resp.Schema.Attributes["triggers"].(schema.MapAttribute)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to configure triggers attribute", "Failed to configure triggers attribute")
+		return
+	}
+	triggersAttr.PlanModifiers = append(triggersAttr.PlanModifiers, mapplanmodifier.RequiresReplace())
+	resp.Schema.Attributes["triggers"] = triggersAttr
+	for _, name := range []string{"id", "invalidated_at"} {
+		attr, ok := resp.Schema.Attributes[name].(schema.StringAttribute)
+		if !ok {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to configure %s attribute", name), fmt.Sprintf("Failed to configure %s attribute", name))
+			return
+		}
+		attr.PlanModifiers = []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
+		resp.Schema.Attributes[name] = attr
+	}
+}
+
+func (r *TokenInvalidationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("database"),
+			path.MatchRoot("group"),
+		),
+	}
+}
+
+func (r *TokenInvalidationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tursoProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *tursoProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.tursoProviderConfig = client
+}
+
+// Create invalidates the tokens. Every other attribute forces a new resource,
+// so changing the triggers invalidates the tokens again.
+func (r *TokenInvalidationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_token_invalidation.TokenInvalidationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if isProvided(data.Database) {
+		name := data.Database.ValueString()
+		if err := r.invalidateDatabaseTokens(ctx, name); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to invalidate the tokens of database %q, got error: %s", name, err))
+			return
+		}
+		data.Id = data.Database
+	} else {
+		name := data.Group.ValueString()
+		if err := r.invalidateGroupTokens(ctx, name); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to invalidate the tokens of group %q, got error: %s", name, err))
+			return
+		}
+		data.Id = data.Group
+	}
+	data.InvalidatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read keeps the state as is. The API does not report when tokens were last
+// invalidated, so the read timeout is only checked.
+func (r *TokenInvalidationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_token_invalidation.TokenInvalidationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only applies changes to the timeouts, since every other attribute
+// forces a new resource.
+func (r *TokenInvalidationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_token_invalidation.TokenInvalidationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource from the state. Invalidated tokens stay
+// invalid, so the delete timeout is only checked.
+func (r *TokenInvalidationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_token_invalidation.TokenInvalidationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+}
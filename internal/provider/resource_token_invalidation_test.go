@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/resource_token_invalidation"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccResourceTokenInvalidation(t *testing.T) {
+	name := randomName()
+	config := func(rotation string) string {
+		return testAccCreateConfig(`
+		resource "turso_database" "test" {
+			group = "test"
+			name = "` + name + `"
+		}
+
+		resource "turso_token_invalidation" "test" {
+			database = turso_database.test.name
+			triggers = {
+				rotation = "` + rotation + `"
+			}
+		}`)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("turso_token_invalidation.test", "id", name),
+					resource.TestCheckResourceAttrSet("turso_token_invalidation.test", "invalidated_at"),
+				),
+			},
+
+			// Changing the triggers invalidates the tokens again
+			{
+				Config: config("2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("turso_token_invalidation.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testTokenInvalidationModel(database, group string) resource_token_invalidation.TokenInvalidationModel {
+	data := resource_token_invalidation.TokenInvalidationModel{
+		Database:      types.StringNull(),
+		Group:         types.StringNull(),
+		Id:            types.StringUnknown(),
+		InvalidatedAt: types.StringUnknown(),
+		Triggers: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"rotation": types.StringValue("1"),
+		}),
+		Timeouts: testTimeoutsNull(),
+	}
+	if database != "" {
+		data.Database = types.StringValue(database)
+	}
+	if group != "" {
+		data.Group = types.StringValue(group)
+	}
+	return data
+}
+
+func createTokenInvalidation(t *testing.T, ctx context.Context, r *TokenInvalidationResource, data resource_token_invalidation.TokenInvalidationModel) fwresource.CreateResponse {
+	t.Helper()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("error building schema: %v", schemaResp.Diagnostics)
+	}
+	s := schemaResp.Schema
+	req := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	if diags := req.Plan.Set(ctx, data); diags.HasError() {
+		t.Fatalf("error encoding plan: %v", diags)
+	}
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, req, &resp)
+	return resp
+}
+
+func TestTokenInvalidationResourceCreate(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	config := fake.start(t)
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: config}, "test", "sjc", "sjc")
+	fake.addDatabase("app", "test", "app-test-org.turso.io")
+	r := &TokenInvalidationResource{tursoProviderConfig: config}
+
+	tests := []struct {
+		name      string
+		data      resource_token_invalidation.TokenInvalidationModel
+		wantID    string
+		wantCalls []string
+	}{
+		{
+			name:      "database",
+			data:      testTokenInvalidationModel("app", ""),
+			wantID:    "app",
+			wantCalls: []string{"InvalidateDatabaseTokens app"},
+		},
+		{
+			name:      "group",
+			data:      testTokenInvalidationModel("", "test"),
+			wantID:    "test",
+			wantCalls: []string{"InvalidateGroupTokens test"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake.calls = nil
+			before := time.Now().UTC().Truncate(time.Second)
+			resp := createTokenInvalidation(t, ctx, r, tt.data)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var calls []string
+			calls = append(calls, fake.callsTo("InvalidateDatabaseTokens")...)
+			calls = append(calls, fake.callsTo("InvalidateGroupTokens")...)
+			if !slices.Equal(calls, tt.wantCalls) {
+				t.Errorf("expected calls %v, got %v", tt.wantCalls, calls)
+			}
+
+			var state resource_token_invalidation.TokenInvalidationModel
+			if diags := resp.State.Get(ctx, &state); diags.HasError() {
+				t.Fatalf("error decoding state: %v", diags)
+			}
+			if state.Id.ValueString() != tt.wantID {
+				t.Errorf("expected id %s, got %s", tt.wantID, state.Id)
+			}
+			invalidatedAt, err := time.Parse(time.RFC3339, state.InvalidatedAt.ValueString())
+			if err != nil {
+				t.Fatalf("error parsing invalidated_at: %v", err)
+			}
+			if invalidatedAt.Before(before) {
+				t.Errorf("expected invalidated_at after %s, got %s", before, invalidatedAt)
+			}
+		})
+	}
+}
+
+func TestTokenInvalidationResourceCreate_NotFound(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	r := &TokenInvalidationResource{tursoProviderConfig: fake.start(t)}
+
+	resp := createTokenInvalidation(t, ctx, r, testTokenInvalidationModel("missing", ""))
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected an error for the missing database")
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("expected no state, got %s", resp.State.Raw)
+	}
+}
+
+func TestTokenInvalidationResource_ChecksTimeouts(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeTurso()
+	config := fake.start(t)
+	createGroup(t, ctx, &GroupResource{tursoProviderConfig: config}, "test", "sjc", "sjc")
+	fake.addDatabase("app", "test", "app-test-org.turso.io")
+	r := &TokenInvalidationResource{tursoProviderConfig: config}
+
+	resp := createTokenInvalidation(t, ctx, r, testTokenInvalidationModel("app", ""))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var data resource_token_invalidation.TokenInvalidationModel
+	if diags := resp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("error decoding state: %v", diags)
+	}
+
+	for _, name := range []string{"read", "delete"} {
+		t.Run(name, func(t *testing.T) {
+			state := tfsdk.State{Schema: resp.State.Schema}
+			data := data
+			data.Timeouts = testTimeouts(name, "soon")
+			if diags := state.Set(ctx, data); diags.HasError() {
+				t.Fatalf("error encoding state: %v", diags)
+			}
+
+			var diags diag.Diagnostics
+			if name == "read" {
+				readResp := fwresource.ReadResponse{State: state}
+				r.Read(ctx, fwresource.ReadRequest{State: state}, &readResp)
+				diags = readResp.Diagnostics
+			} else {
+				deleteResp := fwresource.DeleteResponse{State: state}
+				r.Delete(ctx, fwresource.DeleteRequest{State: state}, &deleteResp)
+				diags = deleteResp.Diagnostics
+			}
+			if !diags.HasError() {
+				t.Errorf("expected an error for the invalid %s timeout", name)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/celest-dev/terraform-provider-turso/internal/tursoclient"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// sizeUnits holds the number of bytes in each size unit. As with the Turso
+// CLI, units without an i are powers of 1000 and units with an i are powers
+// of 1024.
+var sizeUnits = map[string]int64{
+	"":    1,
+	"b":   1,
+	"k":   1e3,
+	"kb":  1e3,
+	"m":   1e6,
+	"mb":  1e6,
+	"g":   1e9,
+	"gb":  1e9,
+	"t":   1e12,
+	"tb":  1e12,
+	"ki":  1 << 10,
+	"kib": 1 << 10,
+	"mi":  1 << 20,
+	"mib": 1 << 20,
+	"gi":  1 << 30,
+	"gib": 1 << 30,
+	"ti":  1 << 40,
+	"tib": 1 << 40,
+}
+
+// parseSize returns the number of bytes in a size such as 256mb or 1.5gb. The
+// unit is case-insensitive and defaults to bytes.
+func parseSize(size string) (int64, error) {
+	s := strings.TrimSpace(size)
+	unitStart := strings.IndexFunc(s, func(r rune) bool {
+		return r != '.' && !unicode.IsDigit(r)
+	})
+	if unitStart < 0 {
+		unitStart = len(s)
+	}
+	number, unit := s[:unitStart], strings.ToLower(strings.TrimSpace(s[unitStart:]))
+	if number == "" {
+		return 0, fmt.Errorf("invalid size %q: expected a number of bytes, optionally followed by a unit such as mb or gb", size)
+	}
+	multiplier, ok := sizeUnits[unit]
+	if !ok {
+		return 0, fmt.Errorf("invalid size %q: unknown unit %q", size, unit)
+	}
+	// Parse the number exactly, so that sizes such as 1.1mb are whole.
+	value, ok := new(big.Rat).SetString(number)
+	if !ok {
+		return 0, fmt.Errorf("invalid size %q: invalid number %q", size, number)
+	}
+	bytes := value.Mul(value, new(big.Rat).SetInt64(multiplier))
+	if !bytes.IsInt() {
+		return 0, fmt.Errorf("invalid size %q: not a whole number of bytes", size)
+	}
+	if !bytes.Num().IsInt64() {
+		return 0, fmt.Errorf("invalid size %q: too large", size)
+	}
+	return bytes.Num().Int64(), nil
+}
+
+// optSize returns a size as a number of bytes, so that the Turso API does not
+// need to agree with the provider on the meaning of each unit.
+func optSize(s basetypes.StringValue) (tursoclient.OptString, error) {
+	if s.IsNull() || s.IsUnknown() {
+		return tursoclient.OptString{}, nil
+	}
+	bytes, err := parseSize(s.ValueString())
+	if err != nil {
+		return tursoclient.OptString{}, err
+	}
+	return tursoclient.NewOptString(strconv.FormatInt(bytes, 10)), nil
+}
+
+// sameSize reports whether two sizes are the same number of bytes, such as
+// 1gb and 1000mb.
+func sameSize(a, b basetypes.StringValue) bool {
+	if a.Equal(b) {
+		return true
+	}
+	if !isProvided(a) || !isProvided(b) {
+		return false
+	}
+	aBytes, err := parseSize(a.ValueString())
+	if err != nil {
+		return false
+	}
+	bBytes, err := parseSize(b.ValueString())
+	if err != nil {
+		return false
+	}
+	return aBytes == bBytes
+}
+
+// sizeValidator validates that a string is a size which parseSize accepts.
+type sizeValidator struct{}
+
+func (v sizeValidator) Description(ctx context.Context) string {
+	return "value must be a number of bytes, optionally followed by a unit such as mb or gb"
+}
+
+func (v sizeValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a number of bytes, optionally followed by a unit such as `mb` or `gb`"
+}
+
+func (v sizeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := parseSize(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid size", err.Error())
+	}
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSameSize(t *testing.T) {
+	tests := []struct {
+		a, b types.String
+		want bool
+	}{
+		{a: types.StringValue("1gb"), b: types.StringValue("1000mb"), want: true},
+		{a: types.StringValue("1GiB"), b: types.StringValue("1073741824"), want: true},
+		{a: types.StringValue("1gb"), b: types.StringValue("1gib")},
+		{a: types.StringValue("1gb"), b: types.StringNull()},
+		{a: types.StringNull(), b: types.StringNull(), want: true},
+		{a: types.StringValue("1 gigabyte"), b: types.StringValue("1gb")},
+	}
+	for _, tt := range tests {
+		if got := sameSize(tt.a, tt.b); got != tt.want {
+			t.Errorf("sameSize(%s, %s) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSizeValidator(t *testing.T) {
+	for size, valid := range map[string]bool{
+		"256mb":    true,
+		"1.5 GiB":  true,
+		"1.5b":     false,
+		"1 gallon": false,
+	} {
+		req := validator.StringRequest{Path: path.Root("size_limit"), ConfigValue: types.StringValue(size)}
+		var resp validator.StringResponse
+		sizeValidator{}.ValidateString(context.Background(), req, &resp)
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("%q: expected valid %v, got %v", size, valid, resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// upgradeStateJSON upgrades the raw JSON state of a resource saved with an
+// earlier schema version. upgrade edits the attributes of the saved state,
+// which are then decoded with the current schema, so attributes the earlier
+// version did not have are null unless upgrade sets them.
+func upgradeStateJSON(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse, upgrade func(attributes map[string]any)) {
+	if req.RawState == nil || req.RawState.JSON == nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", "The saved state is not in JSON format. Please report this issue to the provider developers.")
+		return
+	}
+
+	// Numbers are kept as written rather than converted to float64.
+	decoder := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+	decoder.UseNumber()
+	var attributes map[string]any
+	if err := decoder.Decode(&attributes); err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to decode the saved state, got error: %s", err))
+		return
+	}
+	upgrade(attributes)
+	upgraded, err := json.Marshal(attributes)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to encode the upgraded state, got error: %s", err))
+		return
+	}
+
+	rawState := tfprotov6.RawState{JSON: upgraded}
+	value, err := rawState.Unmarshal(resp.State.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to decode the upgraded state, got error: %s", err))
+		return
+	}
+	resp.State.Raw = value
+}
+
+// setDefault sets the attribute to value if the saved state has no value for
+// it.
+func setDefault(attributes map[string]any, name string, value any) {
+	if attributes[name] == nil {
+		attributes[name] = value
+	}
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// upgradeState upgrades the state saved as JSON with the given schema version
+// using the upgrader of r, as Terraform does before reading it.
+func upgradeState(t *testing.T, ctx context.Context, r fwresource.ResourceWithUpgradeState, s schema.Schema, version int64, state string) fwresource.UpgradeStateResponse {
+	t.Helper()
+
+	upgrader, ok := r.UpgradeState(ctx)[version]
+	if !ok {
+		t.Fatalf("no state upgrader for version %d", version)
+	}
+	req := fwresource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(state)}}
+	resp := fwresource.UpgradeStateResponse{State: tfsdk.State{Schema: s}}
+	upgrader.StateUpgrader(ctx, req, &resp)
+	return resp
+}
+
+func TestUpgradeStateJSON_InvalidState(t *testing.T) {
+	ctx := context.Background()
+	r := &GroupResource{}
+	s := testGroupSchema(t, ctx, r)
+
+	tests := []struct {
+		name  string
+		state string
+		want  string
+	}{
+		{
+			name:  "malformed",
+			state: `{"name":`,
+			want:  "Unable to decode the saved state",
+		},
+		{
+			name:  "unsupported attribute",
+			state: `{"name":"test","unsupported":true}`,
+			want:  "Unable to decode the upgraded state",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := upgradeState(t, ctx, r, s, 0, tt.state)
+			if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics[0].Detail(), tt.want) {
+				t.Errorf("expected an error containing %q, got %v", tt.want, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -20,6 +23,12 @@ import (
 func DatabaseResourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"children": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "The names of the child databases using this database as their schema, if this is a schema database. See [Multi-DB Schemas](/features/multi-db-schemas).",
+				MarkdownDescription: "The names of the child databases using this database as their schema, if this is a schema database. See [Multi-DB Schemas](/features/multi-db-schemas).",
+			},
 			"database": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"allow_attach": schema.BoolAttribute{
@@ -57,11 +66,21 @@ func DatabaseResourceSchema(ctx context.Context) schema.Schema {
 						Description:         "The DNS hostname used for client libSQL and HTTP connections.",
 						MarkdownDescription: "The DNS hostname used for client libSQL and HTTP connections.",
 					},
+					"http_url": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The URL used for client HTTP connections, which is the hostname with the https scheme.",
+						MarkdownDescription: "The URL used for client HTTP connections, which is the `hostname` with the `https` scheme.",
+					},
 					"is_schema": schema.BoolAttribute{
 						Computed:            true,
 						Description:         "If this database controls other child databases then this will be `true`. See [Multi-DB Schemas](/features/multi-db-schemas).",
 						MarkdownDescription: "If this database controls other child databases then this will be `true`. See [Multi-DB Schemas](/features/multi-db-schemas).",
 					},
+					"libsql_url": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The URL used for client libSQL connections, which is the hostname with the libsql scheme.",
+						MarkdownDescription: "The URL used for client libSQL connections, which is the `hostname` with the `libsql` scheme.",
+					},
 					"name": schema.StringAttribute{
 						Computed:            true,
 						Description:         "The database name, **unique** across your organization.",
@@ -102,10 +121,15 @@ func DatabaseResourceSchema(ctx context.Context) schema.Schema {
 				},
 				Computed: true,
 			},
+			"final_snapshot": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The path of a file to export the contents of the database to as a SQL dump before it is destroyed, including when it is replaced. The database is not deleted if the export fails. The path is read from the state, so it must be applied before the database is destroyed.",
+				MarkdownDescription: "The path of a file to export the contents of the database to as a SQL dump before it is destroyed, including when it is replaced. The database is not deleted if the export fails. The path is read from the state, so it must be applied before the database is destroyed.",
+			},
 			"group": schema.StringAttribute{
 				Required:            true,
-				Description:         "The name of the group where the database should be created. **The group must already exist.**",
-				MarkdownDescription: "The name of the group where the database should be created. **The group must already exist.**",
+				Description:         "The name of the group where the database should be created. **The group must already exist.** Changing the group replaces the database with a copy of it in the new group: a dump of it seeds a temporary database in the new group, the original is deleted once the row count of every table of the copy matches it, and the database is recreated in the new group from the copy. Writes are blocked while the database is copied, and it is unavailable until it is recreated. Moving deletes the original, so deletion protection must be turned off and the final snapshot is written first. Schema databases and their children cannot be moved.",
+				MarkdownDescription: "The name of the group where the database should be created. **The group must already exist.** Changing the group replaces the database with a copy of it in the new group: a dump of it seeds a temporary database in the new group, the original is deleted once the row count of every table of the copy matches it, and the database is recreated in the new group from the copy. Writes are blocked while the database is copied, and it is unavailable until it is recreated. Moving deletes the original, so deletion protection must be turned off and the final snapshot is written first. Schema databases and their children cannot be moved.",
 			},
 			"id": schema.StringAttribute{
 				Optional:            true,
@@ -116,8 +140,8 @@ func DatabaseResourceSchema(ctx context.Context) schema.Schema {
 			"is_schema": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
-				Description:         "Mark this database as the parent schema database that updates child databases with any schema changes. See [Multi-DB Schemas](/features/multi-db-schemas).",
-				MarkdownDescription: "Mark this database as the parent schema database that updates child databases with any schema changes. See [Multi-DB Schemas](/features/multi-db-schemas).",
+				Description:         "Mark this database as the parent schema database that updates child databases with any schema changes. A schema database cannot be destroyed while it has children. See [Multi-DB Schemas](/features/multi-db-schemas).",
+				MarkdownDescription: "Mark this database as the parent schema database that updates child databases with any schema changes. A schema database cannot be destroyed while it has children. See [Multi-DB Schemas](/features/multi-db-schemas).",
 			},
 			"name": schema.StringAttribute{
 				Required:            true,
@@ -127,22 +151,51 @@ func DatabaseResourceSchema(ctx context.Context) schema.Schema {
 			"schema": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				Description:         "The name of the parent database to use as the schema. See [Multi-DB Schemas](/features/multi-db-schemas).",
-				MarkdownDescription: "The name of the parent database to use as the schema. See [Multi-DB Schemas](/features/multi-db-schemas).",
+				Description:         "The name of the parent database to use as the schema. The parent must be a schema database in the same group. See [Multi-DB Schemas](/features/multi-db-schemas).",
+				MarkdownDescription: "The name of the parent database to use as the schema. The parent must be a schema database in the same group. See [Multi-DB Schemas](/features/multi-db-schemas).",
 			},
 			"seed": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
+					"database": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The name of the existing database to branch from, optionally at timestamp. Implies the database seed type. The database must be in the same group. Same as name, which is kept for existing configurations.",
+						MarkdownDescription: "The name of the existing database to branch from, optionally at `timestamp`. Implies the `database` seed type. The database must be in the same group. Same as `name`, which is kept for existing configurations.",
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("name"),
+								path.MatchRelative().AtParent().AtName("url"),
+							),
+						},
+					},
+					"dump_file": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The path to a local SQL dump file to seed the database from. The file is uploaded when the database is created and implies the dump seed type. Changing the contents of the file forces a new database. If the file is removed after the database was created, the hash it was seeded with is kept.",
+						MarkdownDescription: "The path to a local SQL dump file to seed the database from. The file is uploaded when the database is created and implies the `dump` seed type. Changing the contents of the file forces a new database. If the file is removed after the database was created, the hash it was seeded with is kept.",
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("database"),
+								path.MatchRelative().AtParent().AtName("name"),
+								path.MatchRelative().AtParent().AtName("timestamp"),
+								path.MatchRelative().AtParent().AtName("url"),
+							),
+						},
+					},
+					"dump_file_hash": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The SHA-256 hash of the contents of dump_file.",
+						MarkdownDescription: "The SHA-256 hash of the contents of `dump_file`.",
+					},
 					"name": schema.StringAttribute{
 						Optional:            true,
 						Computed:            true,
-						Description:         "The name of the existing database when `database` is used as a seed type.",
-						MarkdownDescription: "The name of the existing database when `database` is used as a seed type.",
+						Description:         "The name of the existing database when `database` is used as a seed type. Implies the `database` seed type when `type` is not set. The database must be in the same group.",
+						MarkdownDescription: "The name of the existing database when `database` is used as a seed type. Implies the `database` seed type when `type` is not set. The database must be in the same group.",
 					},
 					"timestamp": schema.StringAttribute{
 						Optional:            true,
 						Computed:            true,
-						Description:         "A formatted [ISO 8601](https://en.wikipedia.org/wiki/ISO_8601) recovery point to create a database from. This must be within the last 24 hours, or 30 days on the scaler plan.",
-						MarkdownDescription: "A formatted [ISO 8601](https://en.wikipedia.org/wiki/ISO_8601) recovery point to create a database from. This must be within the last 24 hours, or 30 days on the scaler plan.",
+						Description:         "A formatted [ISO 8601](https://en.wikipedia.org/wiki/ISO_8601) recovery point to create a database from. This must be within the last 24 hours, or 30 days on the scaler plan. Must be an [RFC 3339](https://datatracker.ietf.org/doc/html/rfc3339) timestamp, e.g. `2024-01-02T15:04:05Z`, and requires `database` or `name`.",
+						MarkdownDescription: "A formatted [ISO 8601](https://en.wikipedia.org/wiki/ISO_8601) recovery point to create a database from. This must be within the last 24 hours, or 30 days on the scaler plan. Must be an [RFC 3339](https://datatracker.ietf.org/doc/html/rfc3339) timestamp, e.g. `2024-01-02T15:04:05Z`, and requires `database` or `name`.",
 					},
 					"type": schema.StringAttribute{
 						Optional:            true,
@@ -171,6 +224,33 @@ func DatabaseResourceSchema(ctx context.Context) schema.Schema {
 				Optional: true,
 				Computed: true,
 			},
+			"seeded_from": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"database": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The name of the database this database was branched from.",
+						MarkdownDescription: "The name of the database this database was branched from.",
+					},
+					"database_id": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The universal unique identifier (UUID) of the database this database was branched from.",
+						MarkdownDescription: "The universal unique identifier (UUID) of the database this database was branched from.",
+					},
+					"timestamp": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The point in time the database was branched at, in RFC 3339 format. Null if it was branched from the latest state of the source database.",
+						MarkdownDescription: "The point in time the database was branched at, in [RFC 3339](https://datatracker.ietf.org/doc/html/rfc3339) format. Null if it was branched from the latest state of the source database.",
+					},
+				},
+				CustomType: SeededFromType{
+					ObjectType: types.ObjectType{
+						AttrTypes: SeededFromValue{}.AttributeTypes(ctx),
+					},
+				},
+				Computed:            true,
+				Description:         "The database this database was created from, when seeded from another database.",
+				MarkdownDescription: "The database this database was created from, when seeded from another database.",
+			},
 			"allow_attach": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -192,25 +272,44 @@ func DatabaseResourceSchema(ctx context.Context) schema.Schema {
 			"size_limit": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				Description:         "The maximum size of the database in bytes. Values with units are also accepted, e.g. 1mb, 256mb, 1gb.",
-				MarkdownDescription: "The maximum size of the database in bytes. Values with units are also accepted, e.g. 1mb, 256mb, 1gb.",
+				Description:         "The maximum size of the database in bytes. Values with units are also accepted, e.g. 1mb, 256mb, 1gb. The units kb, mb, gb and tb are powers of 1000, and kib, mib, gib and tib are powers of 1024. Changing between equivalent values, such as 1gb and 1000mb, does not update the database.",
+				MarkdownDescription: "The maximum size of the database in bytes. Values with units are also accepted, e.g. `1mb`, `256mb`, `1gb`. The units `kb`, `mb`, `gb` and `tb` are powers of 1000, and `kib`, `mib`, `gib` and `tib` are powers of 1024. Changing between equivalent values, such as `1gb` and `1000mb`, does not update the database.",
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Prevent the database from being destroyed, including when it is replaced. Deletion protection must be turned off in an apply before the database can be destroyed. Defaults to the deletion_protection setting of the provider when the database is created.",
+				MarkdownDescription: "Prevent the database from being destroyed, including when it is replaced. Deletion protection must be turned off in an apply before the database can be destroyed. Defaults to the `deletion_protection` setting of the provider when the database is created.",
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Wait after creating the database until it has an instance in every location of its group and its hostname answers health checks. The wait is bounded by the create timeout.",
+				MarkdownDescription: "Wait after creating the database until it has an instance in every location of its group and its hostname answers health checks. The wait is bounded by the `create` timeout.",
+				Default:             booldefault.StaticBool(false),
 			},
 		},
 	}
 }
 
 type DatabaseModel struct {
-	Database    DatabaseValue `tfsdk:"database"`
-	Group       types.String  `tfsdk:"group"`
-	Id          types.String  `tfsdk:"id"`
-	IsSchema    types.Bool    `tfsdk:"is_schema"`
-	Name        types.String  `tfsdk:"name"`
-	Schema      types.String  `tfsdk:"schema"`
-	Seed        SeedValue     `tfsdk:"seed"`
-	AllowAttach types.Bool    `tfsdk:"allow_attach"`
-	BlockReads  types.Bool    `tfsdk:"block_reads"`
-	BlockWrites types.Bool    `tfsdk:"block_writes"`
-	SizeLimit   types.String  `tfsdk:"size_limit"`
+	Children           types.List      `tfsdk:"children"`
+	Database           DatabaseValue   `tfsdk:"database"`
+	Group              types.String    `tfsdk:"group"`
+	Id                 types.String    `tfsdk:"id"`
+	IsSchema           types.Bool      `tfsdk:"is_schema"`
+	Name               types.String    `tfsdk:"name"`
+	Schema             types.String    `tfsdk:"schema"`
+	Seed               SeedValue       `tfsdk:"seed"`
+	SeededFrom         SeededFromValue `tfsdk:"seeded_from"`
+	AllowAttach        types.Bool      `tfsdk:"allow_attach"`
+	BlockReads         types.Bool      `tfsdk:"block_reads"`
+	BlockWrites        types.Bool      `tfsdk:"block_writes"`
+	SizeLimit          types.String    `tfsdk:"size_limit"`
+	DeletionProtection types.Bool      `tfsdk:"deletion_protection"`
+	FinalSnapshot      types.String    `tfsdk:"final_snapshot"`
+	WaitForReady       types.Bool      `tfsdk:"wait_for_ready"`
+	Timeouts           timeouts.Value  `tfsdk:"timeouts"`
 }
 
 var _ basetypes.ObjectTypable = DatabaseType{}
@@ -364,6 +463,24 @@ func (t DatabaseType) ValueFromObject(ctx context.Context, in basetypes.ObjectVa
 			fmt.Sprintf(`hostname expected to be basetypes.StringValue, was: %T`, hostnameAttribute))
 	}
 
+	httpUrlAttribute, ok := attributes["http_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`http_url is missing from object`)
+
+		return nil, diags
+	}
+
+	httpUrlVal, ok := httpUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`http_url expected to be basetypes.StringValue, was: %T`, httpUrlAttribute))
+	}
+
 	isSchemaAttribute, ok := attributes["is_schema"]
 
 	if !ok {
@@ -382,6 +499,24 @@ func (t DatabaseType) ValueFromObject(ctx context.Context, in basetypes.ObjectVa
 			fmt.Sprintf(`is_schema expected to be basetypes.BoolValue, was: %T`, isSchemaAttribute))
 	}
 
+	libsqlUrlAttribute, ok := attributes["libsql_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`libsql_url is missing from object`)
+
+		return nil, diags
+	}
+
+	libsqlUrlVal, ok := libsqlUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`libsql_url expected to be basetypes.StringValue, was: %T`, libsqlUrlAttribute))
+	}
+
 	nameAttribute, ok := attributes["name"]
 
 	if !ok {
@@ -502,7 +637,9 @@ func (t DatabaseType) ValueFromObject(ctx context.Context, in basetypes.ObjectVa
 		DbId:          dbIdVal,
 		Group:         groupVal,
 		Hostname:      hostnameVal,
+		HttpUrl:       httpUrlVal,
 		IsSchema:      isSchemaVal,
+		LibsqlUrl:     libsqlUrlVal,
 		Name:          nameVal,
 		PrimaryRegion: primaryRegionVal,
 		Regions:       regionsVal,
@@ -702,6 +839,24 @@ func NewDatabaseValue(attributeTypes map[string]attr.Type, attributes map[string
 			fmt.Sprintf(`hostname expected to be basetypes.StringValue, was: %T`, hostnameAttribute))
 	}
 
+	httpUrlAttribute, ok := attributes["http_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`http_url is missing from object`)
+
+		return NewDatabaseValueUnknown(), diags
+	}
+
+	httpUrlVal, ok := httpUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`http_url expected to be basetypes.StringValue, was: %T`, httpUrlAttribute))
+	}
+
 	isSchemaAttribute, ok := attributes["is_schema"]
 
 	if !ok {
@@ -720,6 +875,24 @@ func NewDatabaseValue(attributeTypes map[string]attr.Type, attributes map[string
 			fmt.Sprintf(`is_schema expected to be basetypes.BoolValue, was: %T`, isSchemaAttribute))
 	}
 
+	libsqlUrlAttribute, ok := attributes["libsql_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`libsql_url is missing from object`)
+
+		return NewDatabaseValueUnknown(), diags
+	}
+
+	libsqlUrlVal, ok := libsqlUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`libsql_url expected to be basetypes.StringValue, was: %T`, libsqlUrlAttribute))
+	}
+
 	nameAttribute, ok := attributes["name"]
 
 	if !ok {
@@ -840,7 +1013,9 @@ func NewDatabaseValue(attributeTypes map[string]attr.Type, attributes map[string
 		DbId:          dbIdVal,
 		Group:         groupVal,
 		Hostname:      hostnameVal,
+		HttpUrl:       httpUrlVal,
 		IsSchema:      isSchemaVal,
+		LibsqlUrl:     libsqlUrlVal,
 		Name:          nameVal,
 		PrimaryRegion: primaryRegionVal,
 		Regions:       regionsVal,
@@ -926,7 +1101,9 @@ type DatabaseValue struct {
 	DbId          basetypes.StringValue `tfsdk:"db_id"`
 	Group         basetypes.StringValue `tfsdk:"group"`
 	Hostname      basetypes.StringValue `tfsdk:"hostname"`
+	HttpUrl       basetypes.StringValue `tfsdk:"http_url"`
 	IsSchema      basetypes.BoolValue   `tfsdk:"is_schema"`
+	LibsqlUrl     basetypes.StringValue `tfsdk:"libsql_url"`
 	Name          basetypes.StringValue `tfsdk:"name"`
 	PrimaryRegion basetypes.StringValue `tfsdk:"primary_region"`
 	Regions       basetypes.ListValue   `tfsdk:"regions"`
@@ -937,7 +1114,7 @@ type DatabaseValue struct {
 }
 
 func (v DatabaseValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 14)
+	attrTypes := make(map[string]tftypes.Type, 16)
 
 	var val tftypes.Value
 	var err error
@@ -949,7 +1126,9 @@ func (v DatabaseValue) ToTerraformValue(ctx context.Context) (tftypes.Value, err
 	attrTypes["db_id"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["group"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["hostname"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["http_url"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["is_schema"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["libsql_url"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["primary_region"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["regions"] = basetypes.ListType{
@@ -963,7 +1142,7 @@ func (v DatabaseValue) ToTerraformValue(ctx context.Context) (tftypes.Value, err
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 14)
+		vals := make(map[string]tftypes.Value, 16)
 
 		val, err = v.AllowAttach.ToTerraformValue(ctx)
 
@@ -1021,6 +1200,14 @@ func (v DatabaseValue) ToTerraformValue(ctx context.Context) (tftypes.Value, err
 
 		vals["hostname"] = val
 
+		val, err = v.HttpUrl.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["http_url"] = val
+
 		val, err = v.IsSchema.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -1029,6 +1216,14 @@ func (v DatabaseValue) ToTerraformValue(ctx context.Context) (tftypes.Value, err
 
 		vals["is_schema"] = val
 
+		val, err = v.LibsqlUrl.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["libsql_url"] = val
+
 		val, err = v.Name.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -1119,7 +1314,9 @@ func (v DatabaseValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue
 			"db_id":          basetypes.StringType{},
 			"group":          basetypes.StringType{},
 			"hostname":       basetypes.StringType{},
+			"http_url":       basetypes.StringType{},
 			"is_schema":      basetypes.BoolType{},
+			"libsql_url":     basetypes.StringType{},
 			"name":           basetypes.StringType{},
 			"primary_region": basetypes.StringType{},
 			"regions": basetypes.ListType{
@@ -1139,7 +1336,9 @@ func (v DatabaseValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue
 		"db_id":          basetypes.StringType{},
 		"group":          basetypes.StringType{},
 		"hostname":       basetypes.StringType{},
+		"http_url":       basetypes.StringType{},
 		"is_schema":      basetypes.BoolType{},
+		"libsql_url":     basetypes.StringType{},
 		"name":           basetypes.StringType{},
 		"primary_region": basetypes.StringType{},
 		"regions": basetypes.ListType{
@@ -1168,7 +1367,9 @@ func (v DatabaseValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue
 			"db_id":          v.DbId,
 			"group":          v.Group,
 			"hostname":       v.Hostname,
+			"http_url":       v.HttpUrl,
 			"is_schema":      v.IsSchema,
+			"libsql_url":     v.LibsqlUrl,
 			"name":           v.Name,
 			"primary_region": v.PrimaryRegion,
 			"regions":        regionsVal,
@@ -1223,10 +1424,18 @@ func (v DatabaseValue) Equal(o attr.Value) bool {
 		return false
 	}
 
+	if !v.HttpUrl.Equal(other.HttpUrl) {
+		return false
+	}
+
 	if !v.IsSchema.Equal(other.IsSchema) {
 		return false
 	}
 
+	if !v.LibsqlUrl.Equal(other.LibsqlUrl) {
+		return false
+	}
+
 	if !v.Name.Equal(other.Name) {
 		return false
 	}
@@ -1271,7 +1480,9 @@ func (v DatabaseValue) AttributeTypes(ctx context.Context) map[string]attr.Type
 		"db_id":          basetypes.StringType{},
 		"group":          basetypes.StringType{},
 		"hostname":       basetypes.StringType{},
+		"http_url":       basetypes.StringType{},
 		"is_schema":      basetypes.BoolType{},
+		"libsql_url":     basetypes.StringType{},
 		"name":           basetypes.StringType{},
 		"primary_region": basetypes.StringType{},
 		"regions": basetypes.ListType{
@@ -1308,6 +1519,60 @@ func (t SeedType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue)
 
 	attributes := in.Attributes()
 
+	databaseAttribute, ok := attributes["database"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`database is missing from object`)
+
+		return nil, diags
+	}
+
+	databaseVal, ok := databaseAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`database expected to be basetypes.StringValue, was: %T`, databaseAttribute))
+	}
+
+	dumpFileAttribute, ok := attributes["dump_file"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`dump_file is missing from object`)
+
+		return nil, diags
+	}
+
+	dumpFileVal, ok := dumpFileAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`dump_file expected to be basetypes.StringValue, was: %T`, dumpFileAttribute))
+	}
+
+	dumpFileHashAttribute, ok := attributes["dump_file_hash"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`dump_file_hash is missing from object`)
+
+		return nil, diags
+	}
+
+	dumpFileHashVal, ok := dumpFileHashAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`dump_file_hash expected to be basetypes.StringValue, was: %T`, dumpFileHashAttribute))
+	}
+
 	nameAttribute, ok := attributes["name"]
 
 	if !ok {
@@ -1385,11 +1650,14 @@ func (t SeedType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue)
 	}
 
 	return SeedValue{
-		Name:      nameVal,
-		Timestamp: timestampVal,
-		SeedType:  typeVal,
-		Url:       urlVal,
-		state:     attr.ValueStateKnown,
+		Database:     databaseVal,
+		DumpFile:     dumpFileVal,
+		DumpFileHash: dumpFileHashVal,
+		Name:         nameVal,
+		Timestamp:    timestampVal,
+		SeedType:     typeVal,
+		Url:          urlVal,
+		state:        attr.ValueStateKnown,
 	}, diags
 }
 
@@ -1456,6 +1724,60 @@ func NewSeedValue(attributeTypes map[string]attr.Type, attributes map[string]att
 		return NewSeedValueUnknown(), diags
 	}
 
+	databaseAttribute, ok := attributes["database"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`database is missing from object`)
+
+		return NewSeedValueUnknown(), diags
+	}
+
+	databaseVal, ok := databaseAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`database expected to be basetypes.StringValue, was: %T`, databaseAttribute))
+	}
+
+	dumpFileAttribute, ok := attributes["dump_file"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`dump_file is missing from object`)
+
+		return NewSeedValueUnknown(), diags
+	}
+
+	dumpFileVal, ok := dumpFileAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`dump_file expected to be basetypes.StringValue, was: %T`, dumpFileAttribute))
+	}
+
+	dumpFileHashAttribute, ok := attributes["dump_file_hash"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`dump_file_hash is missing from object`)
+
+		return NewSeedValueUnknown(), diags
+	}
+
+	dumpFileHashVal, ok := dumpFileHashAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`dump_file_hash expected to be basetypes.StringValue, was: %T`, dumpFileHashAttribute))
+	}
+
 	nameAttribute, ok := attributes["name"]
 
 	if !ok {
@@ -1533,11 +1855,14 @@ func NewSeedValue(attributeTypes map[string]attr.Type, attributes map[string]att
 	}
 
 	return SeedValue{
-		Name:      nameVal,
-		Timestamp: timestampVal,
-		SeedType:  typeVal,
-		Url:       urlVal,
-		state:     attr.ValueStateKnown,
+		Database:     databaseVal,
+		DumpFile:     dumpFileVal,
+		DumpFileHash: dumpFileHashVal,
+		Name:         nameVal,
+		Timestamp:    timestampVal,
+		SeedType:     typeVal,
+		Url:          urlVal,
+		state:        attr.ValueStateKnown,
 	}, diags
 }
 
@@ -1609,19 +1934,25 @@ func (t SeedType) ValueType(ctx context.Context) attr.Value {
 var _ basetypes.ObjectValuable = SeedValue{}
 
 type SeedValue struct {
-	Name      basetypes.StringValue `tfsdk:"name"`
-	Timestamp basetypes.StringValue `tfsdk:"timestamp"`
-	SeedType  basetypes.StringValue `tfsdk:"type"`
-	Url       basetypes.StringValue `tfsdk:"url"`
-	state     attr.ValueState
+	Database     basetypes.StringValue `tfsdk:"database"`
+	DumpFile     basetypes.StringValue `tfsdk:"dump_file"`
+	DumpFileHash basetypes.StringValue `tfsdk:"dump_file_hash"`
+	Name         basetypes.StringValue `tfsdk:"name"`
+	Timestamp    basetypes.StringValue `tfsdk:"timestamp"`
+	SeedType     basetypes.StringValue `tfsdk:"type"`
+	Url          basetypes.StringValue `tfsdk:"url"`
+	state        attr.ValueState
 }
 
 func (v SeedValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 4)
+	attrTypes := make(map[string]tftypes.Type, 7)
 
 	var val tftypes.Value
 	var err error
 
+	attrTypes["database"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["dump_file"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["dump_file_hash"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["timestamp"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["type"] = basetypes.StringType{}.TerraformType(ctx)
@@ -1631,7 +1962,31 @@ func (v SeedValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error)
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 4)
+		vals := make(map[string]tftypes.Value, 7)
+
+		val, err = v.Database.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["database"] = val
+
+		val, err = v.DumpFile.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["dump_file"] = val
+
+		val, err = v.DumpFileHash.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["dump_file_hash"] = val
 
 		val, err = v.Name.ToTerraformValue(ctx)
 
@@ -1695,10 +2050,13 @@ func (v SeedValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, di
 	var diags diag.Diagnostics
 
 	attributeTypes := map[string]attr.Type{
-		"name":      basetypes.StringType{},
-		"timestamp": basetypes.StringType{},
-		"type":      basetypes.StringType{},
-		"url":       basetypes.StringType{},
+		"database":       basetypes.StringType{},
+		"dump_file":      basetypes.StringType{},
+		"dump_file_hash": basetypes.StringType{},
+		"name":           basetypes.StringType{},
+		"timestamp":      basetypes.StringType{},
+		"type":           basetypes.StringType{},
+		"url":            basetypes.StringType{},
 	}
 
 	if v.IsNull() {
@@ -1712,10 +2070,13 @@ func (v SeedValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, di
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"name":      v.Name,
-			"timestamp": v.Timestamp,
-			"type":      v.SeedType,
-			"url":       v.Url,
+			"database":       v.Database,
+			"dump_file":      v.DumpFile,
+			"dump_file_hash": v.DumpFileHash,
+			"name":           v.Name,
+			"timestamp":      v.Timestamp,
+			"type":           v.SeedType,
+			"url":            v.Url,
 		})
 
 	return objVal, diags
@@ -1736,6 +2097,18 @@ func (v SeedValue) Equal(o attr.Value) bool {
 		return true
 	}
 
+	if !v.Database.Equal(other.Database) {
+		return false
+	}
+
+	if !v.DumpFile.Equal(other.DumpFile) {
+		return false
+	}
+
+	if !v.DumpFileHash.Equal(other.DumpFileHash) {
+		return false
+	}
+
 	if !v.Name.Equal(other.Name) {
 		return false
 	}
@@ -1765,9 +2138,446 @@ func (v SeedValue) Type(ctx context.Context) attr.Type {
 
 func (v SeedValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"name":      basetypes.StringType{},
-		"timestamp": basetypes.StringType{},
-		"type":      basetypes.StringType{},
-		"url":       basetypes.StringType{},
+		"database":       basetypes.StringType{},
+		"dump_file":      basetypes.StringType{},
+		"dump_file_hash": basetypes.StringType{},
+		"name":           basetypes.StringType{},
+		"timestamp":      basetypes.StringType{},
+		"type":           basetypes.StringType{},
+		"url":            basetypes.StringType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = SeededFromType{}
+
+type SeededFromType struct {
+	basetypes.ObjectType
+}
+
+func (t SeededFromType) Equal(o attr.Type) bool {
+	other, ok := o.(SeededFromType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t SeededFromType) String() string {
+	return "SeededFromType"
+}
+
+func (t SeededFromType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	databaseAttribute, ok := attributes["database"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`database is missing from object`)
+
+		return nil, diags
+	}
+
+	databaseVal, ok := databaseAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`database expected to be basetypes.StringValue, was: %T`, databaseAttribute))
+	}
+
+	databaseIdAttribute, ok := attributes["database_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`database_id is missing from object`)
+
+		return nil, diags
+	}
+
+	databaseIdVal, ok := databaseIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`database_id expected to be basetypes.StringValue, was: %T`, databaseIdAttribute))
+	}
+
+	timestampAttribute, ok := attributes["timestamp"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`timestamp is missing from object`)
+
+		return nil, diags
+	}
+
+	timestampVal, ok := timestampAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`timestamp expected to be basetypes.StringValue, was: %T`, timestampAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return SeededFromValue{
+		Database:   databaseVal,
+		DatabaseId: databaseIdVal,
+		Timestamp:  timestampVal,
+		state:      attr.ValueStateKnown,
+	}, diags
+}
+
+func NewSeededFromValueNull() SeededFromValue {
+	return SeededFromValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewSeededFromValueUnknown() SeededFromValue {
+	return SeededFromValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewSeededFromValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (SeededFromValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing SeededFromValue Attribute Value",
+				"While creating a SeededFromValue value, a missing attribute value was detected. "+
+					"A SeededFromValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("SeededFromValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid SeededFromValue Attribute Type",
+				"While creating a SeededFromValue value, an invalid attribute value was detected. "+
+					"A SeededFromValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("SeededFromValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("SeededFromValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra SeededFromValue Attribute Value",
+				"While creating a SeededFromValue value, an extra attribute value was detected. "+
+					"A SeededFromValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra SeededFromValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewSeededFromValueUnknown(), diags
+	}
+
+	databaseAttribute, ok := attributes["database"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`database is missing from object`)
+
+		return NewSeededFromValueUnknown(), diags
+	}
+
+	databaseVal, ok := databaseAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`database expected to be basetypes.StringValue, was: %T`, databaseAttribute))
+	}
+
+	databaseIdAttribute, ok := attributes["database_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`database_id is missing from object`)
+
+		return NewSeededFromValueUnknown(), diags
+	}
+
+	databaseIdVal, ok := databaseIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`database_id expected to be basetypes.StringValue, was: %T`, databaseIdAttribute))
+	}
+
+	timestampAttribute, ok := attributes["timestamp"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`timestamp is missing from object`)
+
+		return NewSeededFromValueUnknown(), diags
+	}
+
+	timestampVal, ok := timestampAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`timestamp expected to be basetypes.StringValue, was: %T`, timestampAttribute))
+	}
+
+	if diags.HasError() {
+		return NewSeededFromValueUnknown(), diags
+	}
+
+	return SeededFromValue{
+		Database:   databaseVal,
+		DatabaseId: databaseIdVal,
+		Timestamp:  timestampVal,
+		state:      attr.ValueStateKnown,
+	}, diags
+}
+
+func NewSeededFromValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) SeededFromValue {
+	object, diags := NewSeededFromValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewSeededFromValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t SeededFromType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewSeededFromValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewSeededFromValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewSeededFromValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewSeededFromValueMust(SeededFromValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t SeededFromType) ValueType(ctx context.Context) attr.Value {
+	return SeededFromValue{}
+}
+
+var _ basetypes.ObjectValuable = SeededFromValue{}
+
+type SeededFromValue struct {
+	Database   basetypes.StringValue `tfsdk:"database"`
+	DatabaseId basetypes.StringValue `tfsdk:"database_id"`
+	Timestamp  basetypes.StringValue `tfsdk:"timestamp"`
+	state      attr.ValueState
+}
+
+func (v SeededFromValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 3)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["database"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["database_id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["timestamp"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 3)
+
+		val, err = v.Database.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["database"] = val
+
+		val, err = v.DatabaseId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["database_id"] = val
+
+		val, err = v.Timestamp.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["timestamp"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v SeededFromValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v SeededFromValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v SeededFromValue) String() string {
+	return "SeededFromValue"
+}
+
+func (v SeededFromValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"database":    basetypes.StringType{},
+		"database_id": basetypes.StringType{},
+		"timestamp":   basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"database":    v.Database,
+			"database_id": v.DatabaseId,
+			"timestamp":   v.Timestamp,
+		})
+
+	return objVal, diags
+}
+
+func (v SeededFromValue) Equal(o attr.Value) bool {
+	other, ok := o.(SeededFromValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Database.Equal(other.Database) {
+		return false
+	}
+
+	if !v.DatabaseId.Equal(other.DatabaseId) {
+		return false
+	}
+
+	if !v.Timestamp.Equal(other.Timestamp) {
+		return false
+	}
+
+	return true
+}
+
+func (v SeededFromValue) Type(ctx context.Context) attr.Type {
+	return SeededFromType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v SeededFromValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"database":    basetypes.StringType{},
+		"database_id": basetypes.StringType{},
+		"timestamp":   basetypes.StringType{},
 	}
 }
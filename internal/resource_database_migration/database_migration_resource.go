@@ -0,0 +1,73 @@
+package resource_database_migration
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func DatabaseMigrationResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"applied_migrations": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "The IDs of the configured migrations which have been applied to the database, in order.",
+				MarkdownDescription: "The IDs of the configured migrations which have been applied to the database, in order.",
+			},
+			"checksums": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "The SHA-256 checksum of each applied migration, by ID.",
+				MarkdownDescription: "The SHA-256 checksum of each applied migration, by ID.",
+			},
+			"database": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the database to apply the migrations to. Changing this forces a new resource.",
+				MarkdownDescription: "The name of the database to apply the migrations to. Changing this forces a new resource.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The name of the database.",
+				MarkdownDescription: "The name of the database.",
+			},
+			"migration_files": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				Description:         "The paths of the SQL migration files to apply, in order. The ID of each migration is its file name without the extension. New migrations must be appended, and applied migrations must not be changed.",
+				MarkdownDescription: "The paths of the SQL migration files to apply, in order. The ID of each migration is its file name without the extension. New migrations must be appended, and applied migrations must not be changed.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"tracking_table": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The name of the table in the database which records the applied migrations. Changing this forces a new resource.",
+				MarkdownDescription: "The name of the table in the database which records the applied migrations. Changing this forces a new resource.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`), "must contain only letters, numbers and underscores, and not start with a number"),
+				},
+				Default: stringdefault.StaticString("_terraform_migrations"),
+			},
+		},
+	}
+}
+
+type DatabaseMigrationModel struct {
+	AppliedMigrations types.List     `tfsdk:"applied_migrations"`
+	Checksums         types.Map      `tfsdk:"checksums"`
+	Database          types.String   `tfsdk:"database"`
+	Id                types.String   `tfsdk:"id"`
+	MigrationFiles    types.List     `tfsdk:"migration_files"`
+	TrackingTable     types.String   `tfsdk:"tracking_table"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
@@ -5,9 +5,11 @@ package resource_group
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -23,8 +25,8 @@ func GroupResourceSchema(ctx context.Context) schema.Schema {
 			"extensions": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				Description:         "Set to `all` to enable all extensions.",
-				MarkdownDescription: "Set to `all` to enable all extensions.",
+				Description:         "Set to `all` to enable all extensions. Extensions cannot be changed once the group is created; changing this value forces a new group.",
+				MarkdownDescription: "Set to `all` to enable all extensions. Extensions cannot be changed once the group is created; changing this value forces a new group.",
 				Validators: []validator.String{
 					stringvalidator.OneOf(
 						"all",
@@ -91,20 +93,44 @@ func GroupResourceSchema(ctx context.Context) schema.Schema {
 			},
 			"name": schema.StringAttribute{
 				Required:            true,
-				Description:         "The name of the new group.",
-				MarkdownDescription: "The name of the new group.",
+				Description:         "The name of the new group. Must contain only lowercase letters, numbers, dashes. No longer than 64 characters.",
+				MarkdownDescription: "The name of the new group. Must contain only lowercase letters, numbers, dashes. No longer than 64 characters.",
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Prevent the group from being destroyed, including when it is replaced. Deletion protection must be turned off in an apply before the group can be destroyed. Defaults to the deletion_protection setting of the provider when the group is created. Regardless of this setting, a group which still has databases that are not being destroyed by Terraform is only destroyed when it is replaced to change its primary location.",
+				MarkdownDescription: "Prevent the group from being destroyed, including when it is replaced. Deletion protection must be turned off in an apply before the group can be destroyed. Defaults to the `deletion_protection` setting of the provider when the group is created. Regardless of this setting, a group which still has databases that are not being destroyed by Terraform is only destroyed when it is replaced to change its `primary` location.",
+			},
+			"replace_on_primary_change": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Set to `true` to allow a change of `primary` to replace the group. The primary location of an existing group cannot be moved, so the group and all of its databases are destroyed and recreated. When `false`, changing `primary` fails the plan.",
+				MarkdownDescription: "Set to `true` to allow a change of `primary` to replace the group. The primary location of an existing group cannot be moved, so the group and all of its databases are destroyed and recreated. When `false`, changing `primary` fails the plan.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Wait after adding locations until every database in the group has an instance in each location and answers health checks. The wait is bounded by the create and update timeouts.",
+				MarkdownDescription: "Wait after adding locations until every database in the group has an instance in each location and answers health checks. The wait is bounded by the `create` and `update` timeouts.",
+				Default:             booldefault.StaticBool(false),
 			},
 		},
 	}
 }
 
 type GroupModel struct {
-	Extensions types.String `tfsdk:"extensions"`
-	Group      GroupValue   `tfsdk:"group"`
-	Id         types.String `tfsdk:"id"`
-	Primary    types.String `tfsdk:"primary"`
-	Locations  types.Set    `tfsdk:"locations"`
-	Name       types.String `tfsdk:"name"`
+	Extensions             types.String   `tfsdk:"extensions"`
+	Group                  GroupValue     `tfsdk:"group"`
+	Id                     types.String   `tfsdk:"id"`
+	Primary                types.String   `tfsdk:"primary"`
+	Locations              types.Set      `tfsdk:"locations"`
+	Name                   types.String   `tfsdk:"name"`
+	DeletionProtection     types.Bool     `tfsdk:"deletion_protection"`
+	ReplaceOnPrimaryChange types.Bool     `tfsdk:"replace_on_primary_change"`
+	WaitForReady           types.Bool     `tfsdk:"wait_for_ready"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 }
 
 var _ basetypes.ObjectTypable = GroupType{}
@@ -0,0 +1,51 @@
+package resource_token_invalidation
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TokenInvalidationResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The name of the database whose tokens are invalidated. Exactly one of database and group must be set. Changing this forces a new resource.",
+				MarkdownDescription: "The name of the database whose tokens are invalidated. Exactly one of `database` and `group` must be set. Changing this forces a new resource.",
+			},
+			"group": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The name of the group whose tokens, including those of its databases, are invalidated. Exactly one of database and group must be set. Changing this forces a new resource.",
+				MarkdownDescription: "The name of the group whose tokens, including those of its databases, are invalidated. Exactly one of `database` and `group` must be set. Changing this forces a new resource.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The name of the database or group.",
+				MarkdownDescription: "The name of the database or group.",
+			},
+			"invalidated_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "When the tokens were last invalidated, in RFC 3339 format.",
+				MarkdownDescription: "When the tokens were last invalidated, in RFC 3339 format.",
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Arbitrary values which invalidate the tokens again whenever they change, such as a rotation counter or date.",
+				MarkdownDescription: "Arbitrary values which invalidate the tokens again whenever they change, such as a rotation counter or date.",
+			},
+		},
+	}
+}
+
+type TokenInvalidationModel struct {
+	Database      types.String   `tfsdk:"database"`
+	Group         types.String   `tfsdk:"group"`
+	Id            types.String   `tfsdk:"id"`
+	InvalidatedAt types.String   `tfsdk:"invalidated_at"`
+	Triggers      types.Map      `tfsdk:"triggers"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
@@ -857,15 +857,22 @@ func (s *BaseGroup) encodeFields(e *jx.Encoder) {
 			s.Archived.Encode(e)
 		}
 	}
+	{
+		if len(s.Extensions) != 0 {
+			e.FieldStart("extensions")
+			e.Raw(s.Extensions)
+		}
+	}
 }
 
-var jsonFieldsNameOfBaseGroup = [6]string{
+var jsonFieldsNameOfBaseGroup = [7]string{
 	0: "name",
 	1: "version",
 	2: "uuid",
 	3: "locations",
 	4: "primary",
 	5: "archived",
+	6: "extensions",
 }
 
 // Decode decodes BaseGroup from json.
@@ -945,6 +952,17 @@ func (s *BaseGroup) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"archived\"")
 			}
+		case "extensions":
+			if err := func() error {
+				v, err := d.RawAppend(nil)
+				s.Extensions = jx.Raw(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"extensions\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
 
 	ht "github.com/ogen-go/ogen/http"
 )
@@ -437,6 +438,8 @@ type BaseGroup struct {
 	Primary OptString `json:"primary"`
 	// Groups on the free tier get archived after some inactivity.
 	Archived OptBool `json:"archived"`
+	// The extensions enabled for the databases in the group, when reported by the API.
+	Extensions jx.Raw `json:"extensions"`
 }
 
 // GetName returns the value of Name.
@@ -469,6 +472,11 @@ func (s *BaseGroup) GetArchived() OptBool {
 	return s.Archived
 }
 
+// GetExtensions returns the value of Extensions.
+func (s *BaseGroup) GetExtensions() jx.Raw {
+	return s.Extensions
+}
+
 // SetName sets the value of Name.
 func (s *BaseGroup) SetName(val OptString) {
 	s.Name = val
@@ -499,6 +507,11 @@ func (s *BaseGroup) SetArchived(val OptBool) {
 	s.Archived = val
 }
 
+// SetExtensions sets the value of Extensions.
+func (s *BaseGroup) SetExtensions(val jx.Raw) {
+	s.Extensions = val
+}
+
 func (*BaseGroup) transferGroupRes() {}
 
 type CreateDatabaseBadRequest struct {
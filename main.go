@@ -3,8 +3,12 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
+	"io"
 	"log"
+	"os"
 
+	"github.com/celest-dev/terraform-provider-turso/internal/configgen"
 	"github.com/celest-dev/terraform-provider-turso/internal/provider"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -30,6 +34,13 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "generate-config" {
+		if err := generateConfig(os.Args[2:]); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -47,3 +58,47 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// generateConfig writes import blocks and skeleton resources for the groups and
+// databases of an existing organization, so that they are imported by the next
+// terraform apply.
+func generateConfig(args []string) error {
+	flags := flag.NewFlagSet("generate-config", flag.ExitOnError)
+	organization := flags.String("organization", "", "the organization to generate configuration for (required)")
+	apiURL := flags.String("api-url", provider.DefaultAPIURL, "the base URL of the Turso Platform API")
+	output := flags.String("output", "", "the file to write the configuration to (default: standard output)")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s generate-config -organization <name> [-api-url <url>] [-output <file>]\n\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "The API token is read from the TURSO_API_TOKEN environment variable, or else from the Turso CLI.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *organization == "" {
+		flags.Usage()
+		return fmt.Errorf("-organization is required")
+	}
+
+	apiToken := provider.DefaultAPIToken()
+	if apiToken == "" {
+		return fmt.Errorf("an API token is required: set TURSO_API_TOKEN or log into the Turso CLI")
+	}
+	ctx := context.Background()
+	client, err := provider.NewClient(ctx, *apiURL, apiToken)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	return configgen.Generate(ctx, client, *organization, w)
+}